@@ -0,0 +1,313 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// editKind is the kind of a single line edit.
+type editKind uint8
+
+const (
+	editEqual editKind = iota
+	editDelete
+	editInsert
+)
+
+// edit is a single line in a diff script.
+type edit struct {
+	Kind editKind
+	Text string
+}
+
+// hunk is a contiguous region of changed lines. OldLine is the 1-based line
+// number in the old text where the change starts; OldCount is the number of
+// old lines that were replaced. A hunk with an OldCount of 0 is a pure
+// insertion before OldLine.
+type hunk struct {
+	OldLine  int
+	OldCount int
+	Edits    []edit
+}
+
+// splitLines splits s into lines, dropping the line terminators.
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// diffLines computes the shortest edit script turning a into b using Myers'
+// algorithm, in its linear space variant: rather than keeping every step of
+// the search to trace the edits back, it finds the middle of the shortest
+// path and then diffs the halves before and after it the same way. Within
+// each run of changed lines, the deleted lines come before the inserted ones.
+func diffLines(a, b []string) []edit {
+	// Every split of the search space is at most as large as the whole, so
+	// the same furthest reaching paths can be reused for all of them.
+	max := (len(a) + len(b) + 1) / 2
+	d := differ{
+		a:     a,
+		b:     b,
+		fwd:   make([]int, 2*max+3),
+		bwd:   make([]int, 2*max+3),
+		edits: make([]edit, 0, len(a)+len(b)),
+	}
+
+	d.diff(0, len(a), 0, len(b))
+	groupChanges(d.edits)
+
+	return d.edits
+}
+
+// differ holds the state of diffLines.
+type differ struct {
+	a, b []string
+	// fwd and bwd are the furthest reaching x on each diagonal k of the
+	// forward and backward searches for the middle snake, indexed by k
+	// offset by half of their length.
+	fwd, bwd []int
+	edits    []edit
+}
+
+// diff appends the edits turning a[a0:a1] into b[b0:b1].
+func (d *differ) diff(a0, a1, b0, b1 int) {
+	for a0 < a1 && b0 < b1 && d.a[a0] == d.b[b0] {
+		d.edits = append(d.edits, edit{editEqual, d.a[a0]})
+		a0++
+		b0++
+	}
+
+	suffix := 0
+	for a1-suffix > a0 && b1-suffix > b0 && d.a[a1-suffix-1] == d.b[b1-suffix-1] {
+		suffix++
+	}
+	a1 -= suffix
+	b1 -= suffix
+
+	switch {
+	case a0 == a1:
+		for _, line := range d.b[b0:b1] {
+			d.edits = append(d.edits, edit{editInsert, line})
+		}
+	case b0 == b1:
+		for _, line := range d.a[a0:a1] {
+			d.edits = append(d.edits, edit{editDelete, line})
+		}
+	default:
+		// With the common lines at both ends trimmed, the path takes at
+		// least two edits, so both halves are smaller than the whole.
+		x, y := d.middle(a0, a1, b0, b1)
+		d.diff(a0, x, b0, y)
+		d.diff(x, a1, y, b1)
+	}
+
+	for _, line := range d.a[a1 : a1+suffix] {
+		d.edits = append(d.edits, edit{editEqual, line})
+	}
+}
+
+// middle returns a point in the middle of a shortest path turning a[a0:a1]
+// into b[b0:b1], found by searching from both ends at once until the furthest
+// reaching paths overlap. The backward search works on the reversed lines.
+func (d *differ) middle(a0, a1, b0, b1 int) (int, int) {
+	n, m := a1-a0, b1-b0
+	delta := n - m
+	odd := delta%2 != 0
+
+	offset := len(d.fwd) / 2
+	fwd, bwd := d.fwd, d.bwd
+	fwd[offset+1] = 0
+	bwd[offset+1] = 0
+
+	for D := 0; D <= (n+m+1)/2; D++ {
+		for k := -D; k <= D; k += 2 {
+			var x int
+			if k == -D || (k != D && fwd[offset+k-1] < fwd[offset+k+1]) {
+				x = fwd[offset+k+1]
+			} else {
+				x = fwd[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && d.a[a0+x] == d.b[b0+y] {
+				x++
+				y++
+			}
+			fwd[offset+k] = x
+
+			// The backward search has taken D-1 steps so far.
+			if kr := delta - k; odd && kr >= -(D-1) && kr <= D-1 && x+bwd[offset+kr] >= n {
+				return a0 + x, b0 + y
+			}
+		}
+
+		for k := -D; k <= D; k += 2 {
+			var x int
+			if k == -D || (k != D && bwd[offset+k-1] < bwd[offset+k+1]) {
+				x = bwd[offset+k+1]
+			} else {
+				x = bwd[offset+k-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && d.a[a1-1-x] == d.b[b1-1-y] {
+				x++
+				y++
+			}
+			bwd[offset+k] = x
+
+			if kf := delta - k; !odd && kf >= -D && kf <= D && x+fwd[offset+kf] >= n {
+				return a1 - x, b1 - y
+			}
+		}
+	}
+
+	panic("unreachable: the searches always meet")
+}
+
+// groupChanges moves the deleted lines of each run of changed lines in the
+// edit script before the inserted ones, keeping their order.
+func groupChanges(edits []edit) {
+	for i := 0; i < len(edits); {
+		if edits[i].Kind == editEqual {
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(edits) && edits[end].Kind != editEqual {
+			end++
+		}
+
+		sort.SliceStable(edits[i:end], func(x, y int) bool {
+			return edits[i+x].Kind == editDelete && edits[i+y].Kind == editInsert
+		})
+		i = end
+	}
+}
+
+// diffHunks groups the changed lines of an edit script into hunks.
+func diffHunks(edits []edit) []hunk {
+	var hunks []hunk
+	var curr *hunk
+
+	oldLine := 1
+	for _, e := range edits {
+		if e.Kind == editEqual {
+			curr = nil
+			oldLine++
+			continue
+		}
+
+		if curr == nil {
+			hunks = append(hunks, hunk{OldLine: oldLine})
+			curr = &hunks[len(hunks)-1]
+		}
+
+		curr.Edits = append(curr.Edits, e)
+		if e.Kind == editDelete {
+			curr.OldCount++
+			oldLine++
+		}
+	}
+
+	return hunks
+}
+
+// diffContext is the number of unchanged lines shown around each change in a
+// unified diff.
+const diffContext = 3
+
+// ANSI escape codes used to color diffs.
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[31m"
+	ansiGreen = "\x1b[32m"
+	ansiCyan  = "\x1b[36m"
+)
+
+// writeUnifiedDiff writes the edit script as a unified diff of the file to w,
+// colored with ANSI escape codes if color is true. Nothing is written if there
+// are no changes.
+func writeUnifiedDiff(w io.Writer, file string, edits []edit, color bool) error {
+	paint := func(code, s string) string {
+		if !color {
+			return s
+		}
+		return code + s + ansiReset
+	}
+
+	// Line numbers in the old and new text before each edit, 1-based.
+	oldLines := make([]int, len(edits)+1)
+	newLines := make([]int, len(edits)+1)
+	oldLines[0], newLines[0] = 1, 1
+
+	var changes []int
+	for i, e := range edits {
+		oldLines[i+1], newLines[i+1] = oldLines[i], newLines[i]
+		if e.Kind != editInsert {
+			oldLines[i+1]++
+		}
+		if e.Kind != editDelete {
+			newLines[i+1]++
+		}
+		if e.Kind != editEqual {
+			changes = append(changes, i)
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	var b strings.Builder
+	b.WriteString(paint(ansiBold, "--- "+file+".orig") + "\n")
+	b.WriteString(paint(ansiBold, "+++ "+file) + "\n")
+
+	for i := 0; i < len(changes); {
+		start := changes[i] - diffContext
+		if start < 0 {
+			start = 0
+		}
+
+		// Merge the changes whose context overlaps into the same hunk.
+		end := changes[i] + diffContext + 1
+		for i++; i < len(changes) && changes[i]-diffContext <= end; i++ {
+			end = changes[i] + diffContext + 1
+		}
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		oldStart, oldCount := oldLines[start], oldLines[end]-oldLines[start]
+		newStart, newCount := newLines[start], newLines[end]-newLines[start]
+		if oldCount == 0 {
+			oldStart--
+		}
+		if newCount == 0 {
+			newStart--
+		}
+
+		header := fmt.Sprintf("@@ -%d,%d +%d,%d @@", oldStart, oldCount, newStart, newCount)
+		b.WriteString(paint(ansiCyan, header) + "\n")
+
+		for _, e := range edits[start:end] {
+			switch e.Kind {
+			case editEqual:
+				b.WriteString(" " + e.Text + "\n")
+			case editDelete:
+				b.WriteString(paint(ansiRed, "-"+e.Text) + "\n")
+			case editInsert:
+				b.WriteString(paint(ansiGreen, "+"+e.Text) + "\n")
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// applyEdits returns the old and new text that the edit script goes between.
+func applyEdits(edits []edit) (a, b []string) {
+	for _, e := range edits {
+		if e.Kind != editInsert {
+			a = append(a, e.Text)
+		}
+		if e.Kind != editDelete {
+			b = append(b, e.Text)
+		}
+	}
+	return a, b
+}
+
+// lcsLen returns the length of the longest common subsequence of a and b,
+// which the shortest edit script keeps all of.
+func lcsLen(a, b []string) int {
+	lens := make([][]int, len(a)+1)
+	for i := range lens {
+		lens[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lens[i][j] = lens[i+1][j+1] + 1
+			case lens[i+1][j] > lens[i][j+1]:
+				lens[i][j] = lens[i+1][j]
+			default:
+				lens[i][j] = lens[i][j+1]
+			}
+		}
+	}
+	return lens[0][0]
+}
+
+func TestDiffLines(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, rng.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a' + rng.Intn(4)))
+		}
+		return lines
+	}
+
+	for i := 0; i < 2000; i++ {
+		a, b := randomLines(), randomLines()
+		edits := diffLines(a, b)
+
+		gotA, gotB := applyEdits(edits)
+		if strings.Join(gotA, "") != strings.Join(a, "") || strings.Join(gotB, "") != strings.Join(b, "") {
+			t.Fatalf("diffLines(%q, %q) = %v, which doesn't turn one into the other", a, b, edits)
+		}
+
+		var equal int
+		for _, e := range edits {
+			if e.Kind == editEqual {
+				equal++
+			}
+		}
+		if want := lcsLen(a, b); equal != want {
+			t.Fatalf("diffLines(%q, %q) keeps %d lines, want %d", a, b, equal, want)
+		}
+
+		for j := 1; j < len(edits); j++ {
+			if edits[j-1].Kind == editInsert && edits[j].Kind == editDelete {
+				t.Fatalf("diffLines(%q, %q) = %v, which inserts before deleting", a, b, edits)
+			}
+		}
+	}
+}
+
+func TestDiffLinesLarge(t *testing.T) {
+	// Every line changing is the worst case for the search, which must still
+	// not keep a copy of its state per step.
+	a := make([]string, 5000)
+	b := make([]string, len(a))
+	for i := range a {
+		a[i] = fmt.Sprintf("\tmov eax,%d", i)
+		b[i] = fmt.Sprintf("        mov eax, %d", i)
+	}
+
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	edits := diffLines(a, b)
+	runtime.ReadMemStats(&after)
+
+	if len(edits) != len(a)+len(b) {
+		t.Errorf("got %d edits, want %d", len(edits), len(a)+len(b))
+	}
+	if alloc := after.TotalAlloc - before.TotalAlloc; alloc > 1<<20 {
+		t.Errorf("allocated %d bytes, want at most 1 MiB", alloc)
+	}
+}
+
+func TestWriteUnifiedDiff(t *testing.T) {
+	old := splitLines("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n")
+	new := splitLines("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm\n")
+
+	tests := []struct {
+		name  string
+		color bool
+		want  string
+	}{
+		{
+			name: "never",
+			want: "" +
+				"--- f.asm.orig\n" +
+				"+++ f.asm\n" +
+				"@@ -1,5 +1,5 @@\n" +
+				" a\n" +
+				"-b\n" +
+				"+B\n" +
+				" c\n" +
+				" d\n" +
+				" e\n" +
+				"@@ -10,3 +10,4 @@\n" +
+				" j\n" +
+				" k\n" +
+				" l\n" +
+				"+m\n",
+		},
+		{
+			name:  "always",
+			color: true,
+			want: "" +
+				"\x1b[1m--- f.asm.orig\x1b[0m\n" +
+				"\x1b[1m+++ f.asm\x1b[0m\n" +
+				"\x1b[36m@@ -1,5 +1,5 @@\x1b[0m\n" +
+				" a\n" +
+				"\x1b[31m-b\x1b[0m\n" +
+				"\x1b[32m+B\x1b[0m\n" +
+				" c\n" +
+				" d\n" +
+				" e\n" +
+				"\x1b[36m@@ -10,3 +10,4 @@\x1b[0m\n" +
+				" j\n" +
+				" k\n" +
+				" l\n" +
+				"\x1b[32m+m\x1b[0m\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var b strings.Builder
+			if err := writeUnifiedDiff(&b, "f.asm", diffLines(old, new), test.color); err != nil {
+				t.Fatal(err)
+			}
+			if got := b.String(); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestWriteUnifiedDiffUnchanged(t *testing.T) {
+	lines := splitLines("a\nb\n")
+
+	var b strings.Builder
+	if err := writeUnifiedDiff(&b, "f.asm", diffLines(lines, lines), false); err != nil {
+		t.Fatal(err)
+	}
+	if b.Len() > 0 {
+		t.Errorf("wrote %q for no changes", b.String())
+	}
+}
+
+func TestDiffHunks(t *testing.T) {
+	edits := diffLines(splitLines("a\nb\nc\nd\n"), splitLines("a\nx\nc\nd\ne\n"))
+
+	hunks := diffHunks(edits)
+	if len(hunks) != 2 {
+		t.Fatalf("got %d hunks, want 2: %v", len(hunks), hunks)
+	}
+	if h := hunks[0]; h.OldLine != 2 || h.OldCount != 1 {
+		t.Errorf("first hunk replaces %d lines at %d, want 1 at 2", h.OldCount, h.OldLine)
+	}
+	if h := hunks[1]; h.OldLine != 5 || h.OldCount != 0 {
+		t.Errorf("second hunk replaces %d lines at %d, want an insertion at 5", h.OldCount, h.OldLine)
+	}
+}
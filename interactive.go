@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// isTerminal returns true if the file is a terminal.
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return stat.Mode()&os.ModeCharDevice != 0
+}
+
+// confirmWrite prints a summary of the changes formatting would make to the
+// file and asks whether they should be written. It returns true if the user
+// answered yes.
+func confirmWrite(file string, src, out []byte) (bool, error) {
+	edits := diffLines(splitLines(string(src)), splitLines(string(out)))
+	hunks := diffHunks(edits)
+
+	var added, deleted int
+	for _, hunk := range hunks {
+		for _, e := range hunk.Edits {
+			switch e.Kind {
+			case editInsert:
+				added++
+			case editDelete:
+				deleted++
+			}
+		}
+	}
+
+	fmt.Fprintf(os.Stderr,
+		"%s: %d changed region(s), +%d -%d lines. Rewrite? [y/N] ",
+		file, len(hunks), added, deleted)
+
+	answer, err := stdinReader.ReadString('\n')
+	if err != nil && answer == "" {
+		// Treat a closed stdin as declining, but end the prompt's line.
+		fmt.Fprintln(os.Stderr)
+		return false, nil
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -1,60 +1,801 @@
 package main
 
 import (
-	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
 
+	"github.com/diamondburned/nasmfmt/v2/nasm"
 	"github.com/diamondburned/nasmfmt/v2/nasmfmt"
 )
 
 var (
 	insIndent     int
 	commentIndent int
+	commentTabs   int
+	indentUnit    int
+	noIndent      bool
+	alignOperands nasmfmt.AlignOperands
+	alignCommas   bool
+	fixedColumns  []int
+	maxOperands   int
+	fmtComments   bool
+	commentNorm   nasmfmt.CommentNormalize
+	untabComments bool
+	keepScattered bool
+	maxWidth      int
+	labelSep      = nasmfmt.LabelSeparatorTab
+	dividerWidth  int
+	dividerChar   string
+	pseudoCase    nasmfmt.Case
+	instrCase     nasmfmt.Case
+	directiveCase nasmfmt.Case
+	preprocIndent int
+	contIndent    int
+	target        nasmfmt.Target
+	blanksBefore  int
+	blanksAfter   int
+	blanksBanner  int
+	blanksBlocks  int
+	alignTimes    bool
+	alignSections bool
+	rightNumbers  bool
+	equSpacing    bool
+	alignDefines  bool
+	finalNewline  string
+	recursive     bool
+	useStdin      bool
+	useStdout     bool
+	writeInPlace  bool
+	forceLF       bool
+	listOnly      bool
+	showDiff      bool
+	colorMode     string
+	interactive   bool
+	errFormat     string
+	summaryJSON   string
+	canonical     bool
+	stripComments bool
+	lint          bool
+	safeParse     bool
+	dumpBlocks    bool
+	printConfig   bool
+	verify        bool
+	cpuProfile    string
+	memProfile    string
+)
+
+// The exit statuses of nasmfmt, modeled after gofmt and black. Errors take
+// precedence over changes.
+const (
+	// exitOK is returned if nothing went wrong. Rewriting files, which is the
+	// default, or writing them to stdout succeeds even if they changed.
+	exitOK = 0
+	// exitChanged is returned if any file isn't formatted when only checking
+	// for it with -l, -d or -errformat, or if -lint found anything.
+	exitChanged = 1
+	// exitError is returned if any file couldn't be read, parsed or written,
+	// or if the flags are invalid.
+	exitError = 2
 )
 
 func init() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: %s [params] [files...]\nParameters:\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Usage: %s [command] [params] [files...]\n", os.Args[0])
+		fmt.Fprintf(os.Stderr, "Files are formatted in place. The file - (or -stdin) reads from stdin and writes to stdout.\nCommands:\n")
+		for _, cmd := range commands {
+			fmt.Fprintf(os.Stderr, "  %-6s %s\n", cmd.name, cmd.usage)
+		}
+		fmt.Fprintf(os.Stderr, "Parameters:\n")
 		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "Exit status is 0 on success, 1 if -l, -d or -errformat found an unformatted file or -lint found anything, and 2 on errors.\n")
+	}
+	defineFlags(flag.CommandLine)
+
+	for i := range commands {
+		commands[i].flags = newCommandFlags(commands[i])
+	}
+}
+
+// newCommandFlags returns the flag set of the command, which takes the same
+// flags as nasmfmt itself. It's made before any flags are parsed, as defining
+// the flags resets them to their defaults.
+func newCommandFlags(cmd command) *flag.FlagSet {
+	flags := flag.NewFlagSet(cmd.name, flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s %s [params] [files...]\n%s.\nParameters:\n", os.Args[0], cmd.name, cmd.usage)
+		flags.PrintDefaults()
+	}
+	defineFlags(flags)
+	return flags
+}
+
+// defineFlags defines the flags of nasmfmt on the flag set.
+func defineFlags(flags *flag.FlagSet) {
+	flags.IntVar(&insIndent, "ii", 8, "Indentation for instructions in spaces")
+	flags.BoolVar(&noIndent, "no-indent", false, "Put every line at column zero, only aligning the columns within lines and comments")
+	flags.Func("iu", "Indent by multiples of this many spaces, or tabwidth for 8, in place of -ii and -pi (default 0, off)", func(s string) (err error) {
+		indentUnit, err = nasmfmt.ParseIndentUnit(s)
+		return err
+	})
+	flags.IntVar(&commentIndent, "ci", 40, "Indentation for comments in spaces")
+	flags.IntVar(&commentTabs, "ctw", 0, "Move comments after code to the next tab stop of this width, 0 to not")
+	flags.BoolVar(&fmtComments, "fc", true, "Format comments; if false, keep them exactly as written at their original column")
+	flags.BoolVar(&untabComments, "untab", false, "Replace the tabs within comments with spaces")
+	flags.BoolVar(&keepScattered, "keep-scattered", false, "Only align the comments after code in a block if most of them already are, otherwise put each a space after its code")
+	flags.IntVar(&maxWidth, "width", 0, "Move comments that would make their line longer than this onto a line of their own before the code, 0 to never move them")
+	flags.Func("cn", "Whether comments get a space after their semicolon: always, never or preserve-empty (default always)", func(s string) (err error) {
+		commentNorm, err = nasmfmt.ParseCommentNormalize(s)
+		return err
+	})
+	flags.Func("ao", "Which instructions have their operands aligned: block or same-mnemonic (default block)", func(s string) (err error) {
+		alignOperands, err = nasmfmt.ParseAlignOperands(s)
+		return err
+	})
+	flags.BoolVar(&alignCommas, "ac", false, "Align the operand commas of consecutive instructions, and of consecutive data definitions, into columns")
+	flags.Func("cols", "Align operands to these ascending columns, e.g. 16,24,40, rather than to the lines around them (default none)", func(s string) (err error) {
+		fixedColumns, err = nasmfmt.ParseColumns(s)
+		return err
+	})
+	flags.IntVar(&maxOperands, "mo", 0, "Wrap the operands of instructions with more operands than this onto continuation lines, 0 to never wrap")
+	flags.Func("ls", "Separator between a label and code on the same line: tab, space, newline or hanging (default tab)", func(s string) (err error) {
+		labelSep, err = nasmfmt.ParseLabelSeparator(s)
+		return err
+	})
+	flags.IntVar(&dividerWidth, "dw", 0, "Normalize divider comments (e.g. ;-----) to this width, 0 to keep them")
+	flags.StringVar(&dividerChar, "dc", "", "Character to normalize divider comments to, empty to keep each divider's own")
+	flags.Func("pc", "Case of pseudo-instruction keywords such as db and equ: keep, lower or upper (default keep)", func(s string) (err error) {
+		pseudoCase, err = nasmfmt.ParseCase(s)
+		return err
+	})
+	flags.Func("ic", "Case of instruction mnemonics: keep, lower or upper (default keep)", func(s string) (err error) {
+		instrCase, err = nasmfmt.ParseCase(s)
+		return err
+	})
+	flags.Func("dirc", "Case of directive keywords such as global and extern: keep, lower or upper (default keep)", func(s string) (err error) {
+		directiveCase, err = nasmfmt.ParseCase(s)
+		return err
+	})
+	flags.IntVar(&preprocIndent, "pi", 0, "Additional indentation in spaces for each level of preprocessor nesting (%if, %macro...)")
+	flags.IntVar(&contIndent, "cti", 0, "Indentation in spaces for lines continuing a statement ending with a backslash, 0 to keep their own")
+	flags.Func("target", "Output format whose section keyword is used: auto (keep), elf (section) or obj (segment) (default auto)", func(s string) (err error) {
+		target, err = nasmfmt.ParseTarget(s)
+		return err
+	})
+	flags.IntVar(&blanksBefore, "bbs", 1, "Number of blank lines before a section directive")
+	flags.IntVar(&blanksAfter, "bas", 1, "Number of blank lines after a section directive")
+	flags.IntVar(&blanksBlocks, "bbb", 1, "Number of blank lines between blocks of lines other than section directives")
+	flags.IntVar(&blanksBanner, "bab", 0, "Number of blank lines after the comment banner at the start of a file, 0 for the usual single one")
+	flags.BoolVar(&alignSections, "asc", false, "Align the comments of all section directives in a file into one column")
+	flags.BoolVar(&alignTimes, "at", false, "Align the count, pseudo-instruction and value columns of times lines")
+	flags.BoolVar(&rightNumbers, "rn", false, "Right-align the values of data definitions that only define numbers")
+	flags.BoolVar(&alignDefines, "ad", false, "Align runs of %define constants and the equ constants among them into name and value columns")
+	flags.BoolVar(&equSpacing, "es", false, "Put a single space around the binary operators in the values of equ definitions")
+	flags.BoolVar(&stripComments, "sc", false, "Strip all comments")
+	flags.BoolVar(&canonical, "canonical", false, "Write the canonical form of each file to stdout for diffing or hashing, ignoring all other formatting flags")
+	flags.StringVar(&finalNewline, "final-newline", "", "Whether output ends with a newline: always, or preserve the input's (default preserve for stdin, always for files)")
+	flags.BoolVar(&recursive, "r", false, "Format the assembly files in directories recursively")
+	flags.BoolVar(&useStdin, "stdin", false, "Read the source from stdin and write it to stdout, same as passing - as the only file")
+	flags.BoolVar(&useStdout, "stdout", false, "Write formatted files to stdout instead of rewriting them")
+	flags.BoolVar(&writeInPlace, "w", false, "Rewrite files in place, which is already the default; accepted for compatibility with gofmt")
+	flags.BoolVar(&forceLF, "lf", false, "Write LF line endings, which is already the default as CRLF line endings are never kept; accepted for scripts that ask for it")
+	flags.BoolVar(&showDiff, "d", false, "Print a diff of the changes instead of rewriting files")
+	flags.StringVar(&colorMode, "color", "auto", "Color the output of -d: auto (if stdout is a terminal), always or never")
+	flags.BoolVar(&listOnly, "l", false, "List files whose formatting differs instead of rewriting them")
+	flags.BoolVar(&interactive, "i", false, "Show a summary of the changes and ask before rewriting each file")
+	flags.BoolVar(&lint, "lint", false, "Report instructions that look like mistyped pseudo-instructions, such as db0 or byte, and tabs within operands and comments")
+	flags.BoolVar(&safeParse, "safe-parse", false, "Leave files with lines that nasmfmt can only keep as written, such as unknown preprocessor directives, untouched")
+	flags.BoolVar(&verify, "verify", false, "Format each file a second time and fail instead of writing it if the output changes again")
+	flags.BoolVar(&printConfig, "print-config", false, "Print the value of each formatting option for each file and whether it came from a flag, the modeline or the defaults, instead of formatting")
+	flags.BoolVar(&dumpBlocks, "dump-blocks", false, "Print the blocks of lines that are aligned together instead of formatting, for debugging")
+	flags.StringVar(&cpuProfile, "cpuprofile", "", "Write a CPU profile of formatting the files to this path")
+	flags.StringVar(&memProfile, "memprofile", "", "Write a memory profile to this path once the files are formatted")
+	flags.StringVar(&errFormat, "errformat", "", "Report unformatted files instead of rewriting them (github)")
+	flags.StringVar(&summaryJSON, "summary-json", "", "Write a JSON summary of the processed files to this path (- for stdout)")
+}
+
+// command is a subcommand of nasmfmt. Every command takes the formatting
+// flags, and sets the mode that the files are processed in once they're
+// parsed.
+type command struct {
+	name  string
+	usage string
+	setup func()
+	// flags parses the flags given after the command.
+	flags *flag.FlagSet
+}
+
+// commands are the subcommands of nasmfmt. The first one is the default when
+// no command is given, so that "nasmfmt file.asm" formats the file in place.
+var commands = []command{
+	{name: "fmt", usage: "Format the files in place (default)", setup: func() {}},
+	{name: "check", usage: "List the files that aren't formatted, like -l", setup: func() { listOnly = true }},
+	{name: "diff", usage: "Print a diff of the changes formatting would make, like -d", setup: func() { showDiff = true }},
+}
+
+// parseCommand parses the flags in args before the command given as the first
+// argument after them, if any, and the rest with the command's own flags. It
+// returns the command and the files after the flags. Command names are
+// reserved: a file named like one must be given after "--".
+func parseCommand(flags *flag.FlagSet, args []string) (command, []string, error) {
+	if err := flags.Parse(args); err != nil {
+		return commands[0], nil, err
+	}
+
+	rest := flags.Args()
+	if len(rest) == 0 || len(rest) < len(args) && args[len(args)-len(rest)-1] == "--" {
+		return commands[0], rest, nil
+	}
+
+	for _, cmd := range commands {
+		if rest[0] == cmd.name {
+			err := cmd.flags.Parse(rest[1:])
+			return cmd, cmd.flags.Args(), err
+		}
+	}
+
+	return commands[0], rest, nil
+}
+
+// visitFlags calls fn for each flag given on the command line, whether before
+// or after the command.
+func visitFlags(fn func(*flag.Flag)) {
+	flag.Visit(fn)
+	for _, cmd := range commands {
+		cmd.flags.Visit(fn)
 	}
-	flag.IntVar(&insIndent, "ii", 8, "Indentation for instructions in spaces")
-	flag.IntVar(&commentIndent, "ci", 40, "Indentation for comments in spaces")
 }
 
 func main() {
-	flag.Parse()
+	// Parse errors already exit with flag.ExitOnError.
+	cmd, files, _ := parseCommand(flag.CommandLine, os.Args[1:])
+	cmd.setup()
+
+	if useStdin {
+		if len(files) > 0 {
+			fatalf("-stdin cannot be used with file arguments")
+		}
+		files = []string{"-"}
+	}
 
-	if flag.NArg() == 0 {
+	if len(files) == 0 {
 		flag.Usage()
 		return
 	}
 
-	for _, file := range flag.Args() {
-		if err := formatFile(file); err != nil {
-			log.Fatalf("cannot format file %q: %v", file, err)
+	files, err := expandFiles(files)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	switch errFormat {
+	case "", "github":
+	default:
+		fatalf("unknown -errformat %q", errFormat)
+	}
+
+	if utf8.RuneCountInString(dividerChar) > 1 {
+		fatalf("-dc must be a single character, got %q", dividerChar)
+	}
+
+	switch colorMode {
+	case "auto":
+		colorMode = "never"
+		if isTerminal(os.Stdout) {
+			colorMode = "always"
+		}
+	case "always", "never":
+	default:
+		fatalf("unknown -color %q", colorMode)
+	}
+
+	switch finalNewline {
+	case "", "always", "preserve":
+	default:
+		fatalf("unknown -final-newline %q", finalNewline)
+	}
+
+	if canonical {
+		if printConfig {
+			fatalf("-print-config cannot be used with -canonical")
+		}
+		useStdout = true
+	}
+
+	if interactive && !isTerminal(os.Stdin) {
+		log.Println("stdin is not a terminal, listing files instead of asking")
+		interactive = false
+		listOnly = true
+	}
+
+	if summaryJSON == "-" && writesStdout(files) {
+		fatalf("-summary-json - cannot be used when the files or reports are written to stdout too")
+	}
+
+	stopProfiling, err := startProfiling(cpuProfile, memProfile)
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	results := make([]fileResult, len(files))
+	status := exitOK
+
+	for i, file := range files {
+		result, err := processFile(file)
+		if err != nil {
+			log.Printf("cannot format file %q: %v", file, err)
+			result.Diagnostics = append(result.Diagnostics, err.Error())
+		}
+
+		// The exit statuses are ordered by severity.
+		if fileStatus := fileStatus(result, err); fileStatus > status {
+			status = fileStatus
+		}
+
+		results[i] = result
+	}
+
+	if err := stopProfiling(); err != nil {
+		fatalf("%v", err)
+	}
+
+	if summaryJSON != "" {
+		if err := writeSummary(summaryJSON, results); err != nil {
+			fatalf("cannot write summary: %v", err)
+		}
+	}
+
+	os.Exit(status)
+}
+
+// writesStdout returns true if processing the files writes anything to stdout,
+// which is then no place for the summary.
+func writesStdout(files []string) bool {
+	if useStdout || printConfig || dumpBlocks || errFormat != "" || listOnly || showDiff {
+		return true
+	}
+	for _, file := range files {
+		if file == "-" {
+			return true
+		}
+	}
+	return false
+}
+
+// fileStatus returns the exit status for the result of processing a file and
+// the error that it failed with, if any.
+func fileStatus(result fileResult, err error) int {
+	// Only checking for unformatted files fails on changes.
+	checking := errFormat != "" || listOnly || showDiff
+
+	switch {
+	case err != nil:
+		return exitError
+	case checking && result.Changed, result.Lint > 0:
+		return exitChanged
+	default:
+		return exitOK
+	}
+}
+
+// fatalf logs the message and exits with exitError.
+func fatalf(format string, v ...interface{}) {
+	log.Printf(format, v...)
+	os.Exit(exitError)
+}
+
+// sourceExts are the extensions of the files formatted when walking
+// directories.
+var sourceExts = []string{".asm", ".nasm", ".inc", ".mac"}
+
+// expandFiles replaces the directories in files with the source files inside
+// them if -r is given. Directories are an error otherwise.
+func expandFiles(files []string) ([]string, error) {
+	expanded := make([]string, 0, len(files))
+
+	for _, file := range files {
+		if file == "-" {
+			expanded = append(expanded, file)
+			continue
+		}
+
+		stat, err := os.Stat(file)
+		if errors.Is(err, fs.ErrNotExist) && isGlob(file) {
+			// Commands run by go generate aren't run by a shell, so patterns
+			// are expanded here instead.
+			matches, err := expandGlob(file)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, matches...)
+			continue
+		}
+
+		if err != nil || !stat.IsDir() {
+			// Let processing the file report the error.
+			expanded = append(expanded, file)
+			continue
+		}
+
+		if !recursive {
+			return nil, fmt.Errorf("%s is a directory, use -r to format it recursively", file)
+		}
+
+		err = filepath.WalkDir(file, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && isSourceFile(path) {
+				expanded = append(expanded, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("cannot walk %s: %w", file, err)
+		}
+	}
+
+	return expanded, nil
+}
+
+// isGlob returns true if the path contains any of the special characters of
+// filepath.Match.
+func isGlob(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// expandGlob returns the files matching the pattern, with directories expanded
+// like any other argument. A pattern that matches nothing is only warned
+// about, so that formatting an empty set of files succeeds.
+func expandGlob(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+
+	if len(matches) == 0 {
+		log.Printf("warning: no files match %s", pattern)
+		return nil, nil
+	}
+
+	return expandFiles(matches)
+}
+
+func isSourceFile(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	for _, sourceExt := range sourceExts {
+		if ext == sourceExt {
+			return true
+		}
+	}
+	return false
+}
+
+func formatConfig() nasmfmt.FormatConfig {
+	if canonical {
+		cfg := nasmfmt.CanonicalConfig()
+		cfg.StripComments = stripComments
+		return cfg
+	}
+
+	divChar, _ := utf8.DecodeRuneInString(dividerChar)
+	if divChar == utf8.RuneError {
+		divChar = 0
+	}
+
+	return nasmfmt.FormatConfig{
+		InstructionIndent:     insIndent,
+		CommentIndent:         commentIndent,
+		CommentTabWidth:       commentTabs,
+		IndentUnit:            indentUnit,
+		NoIndent:              noIndent,
+		AlignOperands:         alignOperands,
+		AlignCommas:           alignCommas,
+		FixedColumns:          fixedColumns,
+		MaxOperands:           maxOperands,
+		VerbatimComments:      !fmtComments,
+		CommentNormalize:      commentNorm,
+		UntabComments:         untabComments,
+		KeepScatteredComments: keepScattered,
+		MaxLineWidth:          maxWidth,
+		LabelSeparator:        labelSep,
+		DividerWidth:          dividerWidth,
+		DividerChar:           divChar,
+		PseudoCase:            pseudoCase,
+		InstructionCase:       instrCase,
+		DirectiveCase:         directiveCase,
+		PreprocessorIndent:    preprocIndent,
+		ContinuationIndent:    contIndent,
+		Target:                target,
+
+		BlankLinesBeforeSection: blankLines(blanksBefore),
+		BlankLinesAfterSection:  blankLines(blanksAfter),
+		BlankLinesBetweenBlocks: blankLines(blanksBlocks),
+		BlankLinesAfterBanner:   blanksBanner,
+
+		AlignTimes:           alignTimes,
+		AlignSectionComments: alignSections,
+		RightAlignNumbers:    rightNumbers,
+		SpaceEquOperators:    equSpacing,
+		AlignDefines:         alignDefines,
+		StripComments:        stripComments,
+	}
+}
+
+// blankLines returns the value of one of the BlankLines fields of the format
+// config for n blank lines, as its zero value stands for a single one.
+func blankLines(n int) int {
+	if n == 0 {
+		return nasmfmt.NoBlankLines
+	}
+	return n
+}
+
+// fileFormatConfig returns the format config for the given file source. The
+// file's modeline, if any, overrides the defaults but not the flags given on
+// the command line. It also returns where each option came from, keyed by the
+// name of its flag: "flag", "modeline" or "default". With -canonical, which
+// ignores both, there are none.
+func fileFormatConfig(src []byte) (nasmfmt.FormatConfig, map[string]string, error) {
+	cfg := formatConfig()
+	if canonical {
+		return cfg, nil, nil
+	}
+
+	sources := make(map[string]string)
+	for _, key := range nasmfmt.OptionKeys() {
+		sources[key] = "default"
+	}
+
+	setFlags := make(map[string]bool)
+	visitFlags(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+		if _, ok := sources[f.Name]; ok {
+			sources[f.Name] = "flag"
+		}
+	})
+
+	opts, _ := nasmfmt.FindModeline(src)
+
+	for _, opt := range opts {
+		if setFlags[opt.Key] {
+			continue
 		}
+		if err := cfg.SetOption(opt.Key, opt.Value); err != nil {
+			return cfg, sources, fmt.Errorf("modeline: %w", err)
+		}
+		sources[opt.Key] = "modeline"
 	}
+
+	return cfg, sources, nil
 }
 
-func formatFile(file string) error {
-	cfg := nasmfmt.FormatConfig{
-		InstructionIndent: insIndent,
-		CommentIndent:     commentIndent,
+// writeConfig writes the value of each option of the file and where it came
+// from for -print-config, as a table.
+func writeConfig(w io.Writer, file string, cfg nasmfmt.FormatConfig, sources map[string]string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:\n", file)
+
+	tabw := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tabw, "  option\tvalue\tsource")
+	for _, key := range nasmfmt.OptionKeys() {
+		fmt.Fprintf(tabw, "  %s\t%q\t%s\n", key, cfg.Option(key), sources[key])
 	}
+	tabw.Flush()
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// fileResult is the outcome of processing a single file.
+type fileResult struct {
+	Path        string   `json:"path"`
+	Changed     bool     `json:"changed"`
+	Lines       int      `json:"lines"`
+	Diagnostics []string `json:"diagnostics,omitempty"`
+	// Lint is the number of the diagnostics that were found by -lint.
+	Lint int `json:"-"`
+}
+
+// processFile formats the given file. The formatted file is written back in
+// place, unless -errformat or -l is given, in which case the file or the
+// regions of it that would change are reported instead. The file "-" is read
+// from stdin and written to stdout, and -stdout writes every file to stdout.
+func processFile(file string) (fileResult, error) {
+	result := fileResult{Path: file}
+
+	var src []byte
+	var err error
 
 	if file == "-" {
-		return nasmfmt.Format(os.Stdout, os.Stdin, cfg)
+		src, err = io.ReadAll(os.Stdin)
+	} else {
+		src, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return result, fmt.Errorf("cannot read: %w", err)
+	}
+
+	// Don't even try to parse binary files, which can be huge and have no
+	// lines to speak of.
+	if !nasmfmt.IsText(src) {
+		return result, nasmfmt.ErrNotText
+	}
+
+	result.Lines = len(splitLines(string(src)))
+
+	cfg, sources, err := fileFormatConfig(src)
+	if err != nil {
+		return result, err
 	}
 
-	src, err := os.Open(file)
+	if printConfig {
+		return result, writeConfig(os.Stdout, file, cfg, sources)
+	}
+
+	lines, err := nasm.Parse(bytes.NewReader(src))
 	if err != nil {
-		return fmt.Errorf("cannot open: %w", err)
+		return result, err
+	}
+
+	if lint {
+		for _, diag := range nasmfmt.Analyze(lines) {
+			log.Printf("%s:%s", file, diag)
+			result.Diagnostics = append(result.Diagnostics, diag.String())
+			result.Lint++
+		}
+	}
+
+	if dumpBlocks {
+		return result, writeBlocks(os.Stdout, file, nasmfmt.SplitBlocks(lines, cfg))
+	}
+
+	var formatted []byte
+
+	if unsupported := nasmfmt.Unsupported(lines); safeParse && len(unsupported) > 0 {
+		// Pass the file through as it is, which still matters for stdin.
+		log.Printf("warning: leaving %s unformatted, line %s", file, unsupported[0])
+		formatted = src
+	} else {
+		var out bytes.Buffer
+		if err := nasmfmt.FormatLines(&out, lines, cfg); err != nil {
+			return result, err
+		}
+
+		formatted = out.Bytes()
+		if preserveFinalNewline(file) && !bytes.HasSuffix(src, []byte("\n")) {
+			formatted = bytes.TrimRight(formatted, "\n")
+		}
+
+		if verify {
+			if err := verifyStable(formatted, cfg); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	result.Changed = !bytes.Equal(src, formatted)
+
+	switch {
+	case errFormat != "":
+		if result.Changed {
+			reportChanges(file, src, formatted)
+		}
+	case showDiff:
+		edits := diffLines(splitLines(string(src)), splitLines(string(formatted)))
+		if err := writeUnifiedDiff(os.Stdout, file, edits, colorMode == "always"); err != nil {
+			return result, fmt.Errorf("cannot write diff: %w", err)
+		}
+	case listOnly:
+		if result.Changed {
+			fmt.Println(file)
+		}
+	case file == "-" || useStdout:
+		if _, err := os.Stdout.Write(formatted); err != nil {
+			return result, fmt.Errorf("cannot write to stdout: %w", err)
+		}
+	case !result.Changed:
+		// Nothing to write.
+	case interactive:
+		ok, err := confirmWrite(file, src, formatted)
+		if err != nil || !ok {
+			return result, err
+		}
+		fallthrough
+	default:
+		if err := writeFileAtomic(file, formatted); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// verifyStable formats the formatted source again and returns an error if that
+// changes it any further, which would be a bug in the formatter.
+func verifyStable(formatted []byte, cfg nasmfmt.FormatConfig) error {
+	lines, err := nasm.Parse(bytes.NewReader(formatted))
+	if err != nil {
+		return fmt.Errorf("cannot parse formatted output: %w", err)
+	}
+
+	var out bytes.Buffer
+	if err := nasmfmt.FormatLines(&out, lines, cfg); err != nil {
+		return err
+	}
+
+	again := out.Bytes()
+	if !bytes.HasSuffix(formatted, []byte("\n")) {
+		again = bytes.TrimRight(again, "\n")
+	}
+
+	if bytes.Equal(formatted, again) {
+		return nil
+	}
+
+	line := 1
+	for _, e := range diffLines(splitLines(string(formatted)), splitLines(string(again))) {
+		if e.Kind != editEqual {
+			break
+		}
+		line++
+	}
+
+	return fmt.Errorf("formatting isn't stable, line %d changes when formatted again", line)
+}
+
+// writeBlocks writes the blocks of the file for -dump-blocks, one line per
+// parsed line with its source line number and its token type.
+func writeBlocks(w io.Writer, file string, blocks []nasm.Lines) error {
+	var b strings.Builder
+
+	for i, block := range blocks {
+		fmt.Fprintf(&b, "%s: block %d\n", file, i+1)
+		for _, line := range block {
+			kind := "comment"
+			if line.Token != nil {
+				kind = strings.TrimPrefix(fmt.Sprintf("%T", line.Token), "nasm.")
+			}
+			fmt.Fprintf(&b, "%6d  %-18s %q\n", line.SourceLine, kind, line.String())
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// preserveFinalNewline returns true if the output for the given file should
+// only end with a newline if the input did. This is the default for stdin, so
+// that formatting a selection in an editor round-trips cleanly.
+func preserveFinalNewline(file string) bool {
+	switch finalNewline {
+	case "always":
+		return false
+	case "preserve":
+		return true
+	default:
+		return file == "-"
+	}
+}
+
+// writeFileAtomic replaces the file with the given data by writing it to a
+// temporary file next to it first, so that the file is never left half
+// written.
+func writeFileAtomic(file string, data []byte) error {
+	var perm os.FileMode = 0644
+	if stat, err := os.Stat(file); err == nil {
+		perm = stat.Mode().Perm()
 	}
-	defer src.Close()
 
 	dst, err := os.CreateTemp(filepath.Dir(file), ".~*"+filepath.Ext(file))
 	if err != nil {
@@ -63,15 +804,12 @@ func formatFile(file string) error {
 	defer os.Remove(dst.Name())
 	defer dst.Close()
 
-	dstbuf := bufio.NewWriter(dst)
-	defer dstbuf.Flush()
-
-	if err := nasmfmt.Format(dstbuf, src, cfg); err != nil {
-		return err
+	if _, err := dst.Write(data); err != nil {
+		return fmt.Errorf("cannot write temp: %w", err)
 	}
 
-	if err := dstbuf.Flush(); err != nil {
-		return fmt.Errorf("cannot flush write buffer: %w", err)
+	if err := dst.Chmod(perm); err != nil {
+		return fmt.Errorf("cannot chmod temp: %w", err)
 	}
 
 	if err := dst.Close(); err != nil {
@@ -84,3 +822,62 @@ func formatFile(file string) error {
 
 	return nil
 }
+
+// writeSummary writes the results as JSON to the given path, or to stdout if
+// the path is "-".
+func writeSummary(path string, results []fileResult) error {
+	b, err := json.MarshalIndent(results, "", "\t")
+	if err != nil {
+		return err
+	}
+	b = append(b, '\n')
+
+	if path == "-" {
+		_, err = os.Stdout.Write(b)
+		return err
+	}
+
+	return writeFileAtomic(path, b)
+}
+
+// reportChanges reports the regions of src that differ from the formatted out
+// in the format chosen by -errformat.
+func reportChanges(file string, src, out []byte) {
+	edits := diffLines(splitLines(string(src)), splitLines(string(out)))
+	hunks := diffHunks(edits)
+
+	switch errFormat {
+	case "github":
+		for _, hunk := range hunks {
+			writeGitHubAnnotation(file, hunk)
+		}
+	}
+}
+
+// writeGitHubAnnotation writes the hunk to stdout as a GitHub Actions
+// workflow command, which makes it show up inline in pull requests.
+func writeGitHubAnnotation(file string, hunk hunk) {
+	line := hunk.OldLine
+	endLine := hunk.OldLine + hunk.OldCount - 1
+	if hunk.OldCount == 0 {
+		// Pure insertions are annotated on the line before them, if any.
+		if line > 1 {
+			line--
+		}
+		endLine = line
+	}
+
+	fmt.Printf(
+		"::error file=%s,line=%d,endLine=%d::%s\n",
+		escapeGitHubProperty(file), line, endLine,
+		escapeGitHubData("File is not formatted with nasmfmt"),
+	)
+}
+
+var (
+	gitHubDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	gitHubPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
+
+func escapeGitHubData(s string) string     { return gitHubDataEscaper.Replace(s) }
+func escapeGitHubProperty(s string) string { return gitHubPropertyEscaper.Replace(s) }
@@ -0,0 +1,469 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/diamondburned/nasmfmt/v2/nasmfmt"
+)
+
+// saveFlags returns a func that resets the flags that the tests set.
+func saveFlags() func() {
+	l, d, e, li, so, w, lf, v, c := listOnly, showDiff, errFormat, lint, useStdout, writeInPlace, forceLF, verify, colorMode
+	fn, sp := finalNewline, safeParse
+	return func() {
+		listOnly, showDiff, errFormat, lint, useStdout, writeInPlace, forceLF, verify, colorMode = l, d, e, li, so, w, lf, v, c
+		finalNewline, safeParse = fn, sp
+	}
+}
+
+// runFile runs processFile on a file with the source in a temporary directory
+// with the flags set by setup, and returns what it wrote to stdout and the
+// exit status for the file. The flags are reset afterwards.
+func runFile(t *testing.T, src string, setup func()) (result fileResult, stdout string, status int, err error) {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "file.asm")
+	if err := os.WriteFile(file, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return run(t, file, setup)
+}
+
+// runStdin is runFile, but with the source read from stdin.
+func runStdin(t *testing.T, src string, setup func()) (result fileResult, stdout string, status int, err error) {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "stdin")
+	if err := os.WriteFile(file, []byte(src), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	in, err := os.Open(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer in.Close()
+
+	realStdin := os.Stdin
+	os.Stdin = in
+	defer func() { os.Stdin = realStdin }()
+
+	return run(t, "-", setup)
+}
+
+func run(t *testing.T, file string, setup func()) (result fileResult, stdout string, status int, err error) {
+	t.Helper()
+
+	defer saveFlags()()
+	colorMode = "never"
+	setup()
+
+	out, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer out.Close()
+
+	realStdout := os.Stdout
+	os.Stdout = out
+	log.SetOutput(io.Discard)
+	defer func() {
+		os.Stdout = realStdout
+		log.SetOutput(os.Stderr)
+	}()
+
+	result, err = processFile(file)
+	status = fileStatus(result, err)
+
+	b, readErr := os.ReadFile(out.Name())
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+
+	return result, string(b), status, err
+}
+
+func TestParseCommand(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(dir+"/diff", nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	tests := []struct {
+		name    string
+		args    []string
+		command string
+		files   []string
+		l       bool
+	}{
+		{"no command", []string{"a.asm"}, "fmt", []string{"a.asm"}, false},
+		{"command first", []string{"check", "-l", "a.asm"}, "check", []string{"a.asm"}, true},
+		{"flags first", []string{"-l", "check", "a.asm"}, "check", []string{"a.asm"}, true},
+		{"file named as command", []string{"diff", "a.asm"}, "diff", []string{"a.asm"}, false},
+		{"after dashes", []string{"-l", "--", "diff"}, "fmt", []string{"diff"}, true},
+		{"after command dashes", []string{"check", "--", "diff"}, "check", []string{"diff"}, false},
+		{"command after file", []string{"a.asm", "check"}, "fmt", []string{"a.asm", "check"}, false},
+	}
+
+	realCommands := commands
+	defer func() { commands = realCommands }()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer saveFlags()()
+
+			// Flag sets remember which flags they parsed, so every test gets
+			// new ones.
+			flags := flag.NewFlagSet("nasmfmt", flag.ContinueOnError)
+			flags.SetOutput(io.Discard)
+			defineFlags(flags)
+
+			commands = append([]command(nil), realCommands...)
+			for i := range commands {
+				commands[i].flags = newCommandFlags(commands[i])
+			}
+
+			cmd, files, err := parseCommand(flags, test.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if cmd.name != test.command {
+				t.Errorf("command = %q, want %q", cmd.name, test.command)
+			}
+			if !reflect.DeepEqual(files, test.files) {
+				t.Errorf("files = %q, want %q", files, test.files)
+			}
+			if listOnly != test.l {
+				t.Errorf("-l = %v, want %v", listOnly, test.l)
+			}
+		})
+	}
+}
+
+func TestCRLF(t *testing.T) {
+	const src = "\tmov eax, 1\r\n\tret\r\n"
+	const want = "        mov eax, 1\n        ret\n"
+
+	t.Run("lf", func(t *testing.T) {
+		_, stdout, _, err := runFile(t, src, func() {
+			forceLF = true
+			useStdout = true
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stdout != want {
+			t.Errorf("got %q, want %q", stdout, want)
+		}
+	})
+
+	t.Run("list", func(t *testing.T) {
+		result, stdout, _, err := runFile(t, src, func() { listOnly = true })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result.Changed || filepath.Base(stdout) != "file.asm\n" {
+			t.Errorf("file with CRLF line endings not listed as changed, got %q", stdout)
+		}
+	})
+}
+
+func TestExitStatus(t *testing.T) {
+	const formatted = "        mov eax, 1\n"
+	const unformatted = "\tmov eax,1\n"
+
+	tests := []struct {
+		name   string
+		src    string
+		setup  func()
+		status int
+	}{
+		{"write formatted", formatted, func() {}, exitOK},
+		{"write unformatted", unformatted, func() {}, exitOK},
+		{"-w unformatted", unformatted, func() { writeInPlace = true }, exitOK},
+		{"-l formatted", formatted, func() { listOnly = true }, exitOK},
+		{"-l unformatted", unformatted, func() { listOnly = true }, exitChanged},
+		{"-d formatted", formatted, func() { showDiff = true }, exitOK},
+		{"-d unformatted", unformatted, func() { showDiff = true }, exitChanged},
+		{"-errformat unformatted", unformatted, func() { errFormat = "github" }, exitChanged},
+		{"-lint clean", formatted, func() { lint = true; useStdout = true }, exitOK},
+		{"-lint typo", "        db0 1\n", func() { lint = true; useStdout = true }, exitChanged},
+		{"binary", "\x00\x01\x02", func() {}, exitError},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, _, status, err := runFile(t, test.src, test.setup)
+			if status != test.status {
+				t.Errorf("status = %d, want %d (err: %v)", status, test.status, err)
+			}
+		})
+	}
+}
+
+func TestWritesStdout(t *testing.T) {
+	tests := []struct {
+		name  string
+		files []string
+		setup func()
+		want  bool
+	}{
+		{"in place", []string{"a.asm"}, func() {}, false},
+		{"stdin", []string{"-"}, func() {}, true},
+		{"stdout", []string{"a.asm"}, func() { useStdout = true }, true},
+		{"list", []string{"a.asm"}, func() { listOnly = true }, true},
+		{"diff", []string{"a.asm"}, func() { showDiff = true }, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defer saveFlags()()
+			test.setup()
+
+			if got := writesStdout(test.files); got != test.want {
+				t.Errorf("writesStdout(%q) = %v, want %v", test.files, got, test.want)
+			}
+		})
+	}
+}
+
+func TestFinalNewline(t *testing.T) {
+	tests := []struct {
+		name, src, finalNewline, want string
+	}{
+		{"always without", "\tret", "always", "        ret\n"},
+		{"always with", "\tret\n", "always", "        ret\n"},
+		{"preserve without", "\tret", "preserve", "        ret"},
+		{"preserve with", "\tret\n", "preserve", "        ret\n"},
+		{"file default", "\tret", "", "        ret\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, stdout, _, err := runFile(t, test.src, func() {
+				finalNewline = test.finalNewline
+				useStdout = true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stdout != test.want {
+				t.Errorf("got %q, want %q", stdout, test.want)
+			}
+		})
+	}
+}
+
+func TestFinalNewlineStdin(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"\tret", "        ret"},
+		{"\tret\n", "        ret\n"},
+	}
+
+	for _, test := range tests {
+		_, stdout, _, err := runStdin(t, test.src, func() { finalNewline = "" })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stdout != test.want {
+			t.Errorf("got %q, want %q", stdout, test.want)
+		}
+	}
+}
+
+func TestSafeParse(t *testing.T) {
+	tests := []struct {
+		name, src, want string
+	}{
+		{"supported", "\tmov eax,1\n", "        mov eax, 1\n"},
+		{"unknown directive", "%frobnicate  x\n\tmov eax,1\n", "%frobnicate  x\n\tmov eax,1\n"},
+		{"local label", "%$local:\n\tmov eax,1\n", "%$local:\n        mov eax, 1\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, stdout, _, err := runFile(t, test.src, func() {
+				safeParse = true
+				useStdout = true
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stdout != test.want {
+				t.Errorf("got %q, want %q", stdout, test.want)
+			}
+		})
+	}
+}
+
+func TestExpandFilesNoMatch(t *testing.T) {
+	log.SetOutput(io.Discard)
+	defer log.SetOutput(os.Stderr)
+
+	pattern := filepath.Join(t.TempDir(), "*.asm")
+
+	files, err := expandFiles([]string{pattern})
+	if err != nil {
+		t.Fatalf("expandFiles(%q) failed: %v", pattern, err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expandFiles(%q) = %q, want no files", pattern, files)
+	}
+}
+
+func TestWriteSilently(t *testing.T) {
+	for _, src := range []string{"        mov eax, 1\n", "\tmov eax,1\n"} {
+		_, stdout, status, err := runFile(t, src, func() { writeInPlace = true })
+		if err != nil {
+			t.Fatalf("processFile failed: %v", err)
+		}
+		if status != exitOK {
+			t.Errorf("status = %d, want %d", status, exitOK)
+		}
+		if stdout != "" {
+			t.Errorf("stdout = %q, want nothing", stdout)
+		}
+	}
+}
+
+func TestNotText(t *testing.T) {
+	const src = "\x7fELF\x02\x01\x01\x00\x00"
+
+	_, stdout, _, err := runFile(t, src, func() { useStdout = true })
+	if !errors.Is(err, nasmfmt.ErrNotText) {
+		t.Errorf("processFile returned %v, want %v", err, nasmfmt.ErrNotText)
+	}
+	if stdout != "" {
+		t.Errorf("stdout = %q, want nothing", stdout)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	// Formatting this used to add another blank line at the end every time.
+	const src = "" +
+		"\tmov eax, 1 ; a\n" +
+		"\tmovzx eax, byte [rsi] ; b\n" +
+		"; c\n" +
+		"\tnop\n" +
+		"label: ; d\n" +
+		"\tret\n"
+
+	_, stdout, status, err := runFile(t, src, func() { verify = true; useStdout = true })
+	if err != nil {
+		t.Fatalf("verify failed: %v", err)
+	}
+	if status != exitOK {
+		t.Errorf("status = %d, want %d", status, exitOK)
+	}
+
+	cfg := formatConfig()
+	if err := verifyStable([]byte(stdout), cfg); err != nil {
+		t.Errorf("verifyStable failed on its own output: %v", err)
+	}
+
+	// Unformatted source as the output stands in for an unstable formatter.
+	err = verifyStable([]byte("        nop\n\tmov eax,1\n"), cfg)
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("verifyStable returned %v, want an error for line 2", err)
+	}
+}
+
+func TestConfigSources(t *testing.T) {
+	// Only flags given on the command line count, so give -ii on a command
+	// line of its own.
+	realFlags := flag.CommandLine
+	ii, ci := insIndent, commentIndent
+	defer func() {
+		flag.CommandLine = realFlags
+		insIndent, commentIndent = ii, ci
+	}()
+
+	flag.CommandLine = flag.NewFlagSet("nasmfmt", flag.ContinueOnError)
+	flag.IntVar(&insIndent, "ii", 8, "")
+	flag.IntVar(&commentIndent, "ci", 40, "")
+	if err := flag.CommandLine.Parse([]string{"-ii", "4"}); err != nil {
+		t.Fatal(err)
+	}
+
+	const src = "; nasmfmt: ii=2 ci=32\n\tnop\n"
+
+	cfg, sources, err := fileFormatConfig([]byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg.InstructionIndent != 4 || sources["ii"] != "flag" {
+		t.Errorf("ii = %d from %s, want 4 from flag", cfg.InstructionIndent, sources["ii"])
+	}
+	if cfg.CommentIndent != 32 || sources["ci"] != "modeline" {
+		t.Errorf("ci = %d from %s, want 32 from modeline", cfg.CommentIndent, sources["ci"])
+	}
+	if sources["pi"] != "default" {
+		t.Errorf("pi from %s, want default", sources["pi"])
+	}
+
+	var out strings.Builder
+	if err := writeConfig(&out, "file.asm", cfg, sources); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"file.asm:\n  option          value     source\n",
+		"\n  ii              \"4\"       flag\n",
+		"\n  ci              \"32\"      modeline\n",
+		"\n  pi              \"0\"       default\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("config doesn't contain %q:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestConfigSourcesAfterCommand(t *testing.T) {
+	realFlags, realCommands := flag.CommandLine, commands
+	ii := insIndent
+	defer func() {
+		flag.CommandLine, commands = realFlags, realCommands
+		insIndent = ii
+	}()
+
+	flag.CommandLine = flag.NewFlagSet("nasmfmt", flag.ContinueOnError)
+	defineFlags(flag.CommandLine)
+	commands = append([]command(nil), realCommands...)
+	for i := range commands {
+		commands[i].flags = newCommandFlags(commands[i])
+	}
+
+	if _, _, err := parseCommand(flag.CommandLine, []string{"diff", "-ii", "4", "a.asm"}); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, sources, err := fileFormatConfig([]byte("; nasmfmt: ii=2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.InstructionIndent != 4 || sources["ii"] != "flag" {
+		t.Errorf("ii = %d from %s, want 4 from flag", cfg.InstructionIndent, sources["ii"])
+	}
+}
@@ -1,44 +1,72 @@
 package nasm
 
+import "strings"
+
 // NoQuotes replaces all quoted parts of a string with provided replacement.
-// E.g. NoQuotes(`I'm "in love" with donuts`, "x") -> `I'm xxxxxxxxx with donuts`.
+// E.g. NoQuotes(`we "love" donuts`, "x") -> `we xxxxxx donuts`.
+//
+// Strings may be quoted with ', " or `. Backquoted strings may contain
+// backslash escapes, so \` doesn't end them. A quote that is never closed is
+// treated as a string extending to the end of s, since that's what it most
+// likely is.
 //
 // It's useful for performing substring searches ignoring quotations.
 // Index of a substring in a 'NoQuotes' version with len(rep)==1
 // would equal its index in original string.
 func NoQuotes(s, rep string) string {
+	if !strings.ContainsAny(s, "\"'`") {
+		return s
+	}
+
 	sr := []rune(s)
 	repr := []rune(rep)
 	outr := []rune{}
 
 	for len(sr) > 0 {
 		// Find first quotation mark
-		ind := indexRuneAny(sr, `"'`)
-		if ind >= 0 {
-			quote := sr[ind]
-			// Find its pair
-			ind2 := ind + 1 + indexRune(sr[ind+1:], quote)
-			// If it has no pair - include it into output string and skip
-			if ind2 < ind+1 {
-				outr = append(outr, sr[:ind+1]...)
-				sr = sr[ind+1:]
-				continue
-			}
-			// If it's paired - replace it with reps
-			outr = append(outr, sr[:ind]...)
-			outr = append(outr, runesRepeat(repr, ind2-ind+1)...)
-			sr = sr[ind2+1:]
-		} else {
+		ind := indexRuneAny(sr, "\"'`")
+		if ind < 0 {
 			// If no quotation marks found -
 			// include the rest of input string and break the cycle.
 			outr = append(outr, sr...)
-			sr = nil
+			break
+		}
+
+		outr = append(outr, sr[:ind]...)
+
+		// Find its pair
+		end := closingQuote(sr[ind+1:], sr[ind])
+		if end == -1 {
+			// If it has no pair - the string runs until the end.
+			outr = append(outr, runesRepeat(repr, len(sr)-ind)...)
+			break
 		}
+
+		// If it's paired - replace it with reps
+		ind2 := ind + 1 + end
+		outr = append(outr, runesRepeat(repr, ind2-ind+1)...)
+		sr = sr[ind2+1:]
 	}
 
 	return string(outr)
 }
 
+// closingQuote returns the index of the quote closing a string in s, which
+// starts right after the opening quote, or -1 if the string is never closed.
+func closingQuote(s []rune, quote rune) int {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if quote == '`' {
+				i++ // skip the escaped rune
+			}
+		case quote:
+			return i
+		}
+	}
+	return -1
+}
+
 func indexRune(s []rune, rn rune) int {
 	for i := range s {
 		if s[i] == rn {
@@ -0,0 +1,23 @@
+package nasm
+
+import "testing"
+
+func TestNoQuotes(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{`we "love" donuts`, `we xxxxxx donuts`},
+		{`db "a;b", 0 ; c`, `db xxxxx, 0 ; c`},
+		{"db `a\\`b`, 0", "db xxxxxx, 0"},
+		// Unterminated quotes extend to the end of the string.
+		{`db "hello ; not a comment`, `db xxxxxxxxxxxxxxxxxxxxxx`},
+		{`db 'a, b`, `db xxxxx`},
+		{"db `c\\`d", "db xxxxx"},
+	}
+
+	for _, test := range tests {
+		if got := NoQuotes(test.s, "x"); got != test.want {
+			t.Errorf("NoQuotes(%q) = %q, want %q", test.s, got, test.want)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"bufio"
 	"fmt"
 	"io"
+	"strings"
+	"unicode"
 )
 
 // Parser is used by token parsers to help parse Assembly lines.
@@ -14,12 +16,23 @@ type Parser struct {
 	scan *bufio.Scanner
 	curr string
 	next *string
+
+	// continued is true if the last parsed line ended with a backslash, which
+	// makes the current line a continuation of it.
+	continued bool
 }
 
+// MaxLineLength is the length of the longest line that a Parser can scan.
+// It's generous for the sake of generated sources with huge data lines.
+const MaxLineLength = 16 << 20
+
 // NewParser returns a new Parser for the given reader.
 func NewParser(r io.Reader) *Parser {
+	scan := bufio.NewScanner(r)
+	scan.Buffer(nil, MaxLineLength)
+
 	return &Parser{
-		scan: bufio.NewScanner(r),
+		scan: scan,
 	}
 }
 
@@ -83,6 +96,7 @@ func Parse(r io.Reader) (Lines, error) {
 		if err != nil {
 			return parser.Lines, fmt.Errorf("error at line %d: %w", lineIdx+1, err)
 		}
+		line.SourceLine = lineIdx + 1
 		parser.Lines = append(parser.Lines, line)
 	}
 
@@ -91,10 +105,18 @@ func Parse(r io.Reader) (Lines, error) {
 
 func parseLine(scanner *Parser) (Line, error) {
 	line := scanner.Text()
+
+	continued := scanner.continued
+	scanner.continued = continues(line)
+
 	if line == "" {
 		return Line{}, nil
 	}
 
+	if continued {
+		return parseContinuation(scanner, line), nil
+	}
+
 	var token Token
 	var comment CommentToken
 
@@ -118,8 +140,50 @@ func parseLine(scanner *Parser) (Line, error) {
 		return Line{}, fmt.Errorf("excess text %q", line)
 	}
 
+	if instr, ok := token.(InstructionToken); ok {
+		if strings.TrimSpace(comment.Comment) == KeepOperandsPragma {
+			instr.KeepOperands = true
+			token = instr
+		}
+	}
+
 	return Line{
 		Token:   token,
 		Comment: comment,
 	}, nil
 }
+
+// continues returns true if the line is continued on the next one, which is
+// when it ends with a backslash outside of a comment.
+func continues(line string) bool {
+	line = strings.TrimRightFunc(line, unicode.IsSpace)
+	if !strings.HasSuffix(line, "\\") {
+		return false
+	}
+	return !strings.ContainsRune(NoQuotes(line, "x"), ';')
+}
+
+// parseContinuation parses a line that continues the previous one. Its code is
+// kept as written, as it's only a fragment of a statement.
+func parseContinuation(scanner *Parser, line string) Line {
+	var comment CommentToken
+	if token, rest := ParseCommentToken(scanner, line); token != nil {
+		comment = token.(CommentToken)
+		line = rest
+	}
+
+	text := strings.TrimLeftFunc(line, unicode.IsSpace)
+	cont := ContinuationToken{
+		Indent: columnWidth(line[:len(line)-len(text)]),
+		Text:   strings.TrimRightFunc(text, unicode.IsSpace),
+	}
+
+	if cont.Text == "" {
+		return Line{Comment: comment}
+	}
+
+	return Line{
+		Token:   cont,
+		Comment: comment,
+	}
+}
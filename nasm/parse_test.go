@@ -0,0 +1,36 @@
+package nasm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSourceLine(t *testing.T) {
+	const src = "" +
+		"; one\n" +
+		"\n" +
+		"start:\n" +
+		"\n" +
+		"\n" +
+		"\tmov eax, \\\n" +
+		"\t\t1\n" +
+		"\tret\n"
+
+	lines, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []int
+	for _, line := range lines {
+		if !line.IsEmpty() {
+			got = append(got, line.SourceLine)
+		}
+	}
+
+	want := []int{1, 3, 6, 7, 8}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got source lines %v, want %v", got, want)
+	}
+}
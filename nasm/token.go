@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
 type IndentOpts struct {
@@ -29,16 +30,48 @@ func (ls Lines) String() string {
 	return strings.TrimSuffix(b.String(), "\n")
 }
 
+// Clone returns a deep copy of the lines, such that modifying the tokens of the
+// copy never affects the original lines.
+func (ls Lines) Clone() Lines {
+	if ls == nil {
+		return nil
+	}
+
+	clone := make(Lines, len(ls))
+	for i, l := range ls {
+		if instr, ok := l.Token.(InstructionToken); ok {
+			instr.Args = append([]string(nil), instr.Args...)
+			l.Token = instr
+		}
+		clone[i] = l
+	}
+
+	return clone
+}
+
 // Line consists of multiple tokens.
 type Line struct {
 	Token   Token
 	Comment CommentToken
+	// SourceLine is the 1-based number of the line in the source that it was
+	// parsed from, or 0 if it wasn't parsed. It's only informational and never
+	// affects formatting.
+	SourceLine int
 }
 
 func (l Line) IsEmpty() bool {
 	return l.Token == nil && l.Comment == (CommentToken{})
 }
 
+// Continues returns true if the statement on the line is continued on the next
+// line, which is when its code ends with a backslash and it has no comment.
+func (l Line) Continues() bool {
+	if l.Token == nil || l.Comment != (CommentToken{}) {
+		return false
+	}
+	return continues(l.Token.String())
+}
+
 // String formats a line.
 func (l Line) String() string {
 	var b strings.Builder
@@ -52,18 +85,59 @@ func (l Line) String() string {
 	return strings.TrimSuffix(b.String(), "\t")
 }
 
+func keywordSet(keywords []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(keywords))
+	for _, kw := range keywords {
+		set[kw] = struct{}{}
+	}
+	return set
+}
+
+// hasLeadingKeyword returns true if any of the first n words of the line is
+// one of the given lowercase keywords, ignoring case. Words are separated by
+// whitespace, colons and double quotes. It only looks at the start of the
+// line, so it's a cheap way to rule out lines before running the heavier
+// quote masking and regular expressions on them, which matters for the very
+// long lines of generated data.
+func hasLeadingKeyword(line string, n int, keywords map[string]struct{}) bool {
+	isSep := func(r rune) bool {
+		return unicode.IsSpace(r) || r == ':' || r == '"'
+	}
+
+	for i := 0; i < n; i++ {
+		line = strings.TrimLeftFunc(line, isSep)
+		if line == "" {
+			return false
+		}
+
+		end := strings.IndexFunc(line, isSep)
+		if end == -1 {
+			end = len(line)
+		}
+
+		if _, ok := keywords[strings.ToLower(line[:end])]; ok {
+			return true
+		}
+
+		line = line[end:]
+	}
+
+	return false
+}
+
 type Token interface {
 	fmt.Stringer
 	token()
 }
 
-func (CommentToken) token()     {}
-func (SectionToken) token()     {}
-func (DirectiveToken) token()   {}
-func (PseudoToken) token()      {}
-func (MacroToken) token()       {}
-func (LabelToken) token()       {}
-func (InstructionToken) token() {}
+func (CommentToken) token()      {}
+func (SectionToken) token()      {}
+func (DirectiveToken) token()    {}
+func (PseudoToken) token()       {}
+func (MacroToken) token()        {}
+func (LabelToken) token()        {}
+func (InstructionToken) token()  {}
+func (ContinuationToken) token() {}
 
 type TokenParser func(*Parser, string) (Token, string)
 
@@ -91,11 +165,15 @@ func ParseLabelToken(parser *Parser, line string) (Token, string) {
 
 	label := strings.TrimSpace(line[:idx])
 	rest := strings.TrimSpace(line[idx+1:])
-	if strings.Contains(rest, "[") || strings.Contains(rest, "]") {
+
+	// A label followed by code is parsed as part of that code's token, so
+	// only lines consisting of just the label are taken here. rest is
+	// trimmed, so a label followed only by whitespace leaves nothing behind to
+	// be rendered after the colon.
+	if rest != "" {
 		return nil, line
 	}
 
-	line = rest
 	return LabelToken{label}, rest
 }
 
@@ -104,53 +182,132 @@ func (t LabelToken) String() string {
 }
 
 type InstructionToken struct {
+	// Label is the label preceding the instruction on the same line, if any.
+	Label string
 	Instr string
 	Args  []string
+	// RawArgs is the operand text as written, with only the surrounding
+	// whitespace trimmed.
+	RawArgs string
+	// KeepOperands is true if the instruction is marked with the
+	// KeepOperandsPragma, in which case RawArgs should be written instead of
+	// Args.
+	KeepOperands bool
 }
 
-var instrRe = regexp.MustCompile(`\s*(\S+)`)
+// KeepOperandsPragma is the trailing comment that marks an instruction's
+// operands to be kept exactly as written.
+const KeepOperandsPragma = "nasmfmt:keep-operands"
+
+// labelPattern matches a label, including the macro-local labels starting
+// with %% and the context-local ones starting with %$, %$$ and so on, which
+// refer to the contexts pushed by %push. The labels that macros expand %% labels
+// into, such as "..@4321.next", are matched like any other label, so they're
+// aligned the same way.
+const labelPattern = `(?:%%|%\$+)?[\w.$#@~?]+`
+
+var (
+	instrRe      = regexp.MustCompile(`\s*(\S+)`)
+	instrLabelRe = regexp.MustCompile(`^(` + labelPattern + `):\s*`)
+)
 
 func ParseInstructionToken(parser *Parser, line string) (Token, string) {
 	line = strings.TrimLeftFunc(line, unicode.IsSpace)
 	noq := NoQuotes(line, "x")
 
-	instrIdx := instrRe.FindStringSubmatchIndex(noq)
+	var token InstructionToken
+	code, codeNoq := line, noq
+
+	if labelIdx := instrLabelRe.FindStringSubmatchIndex(noq); labelIdx != nil {
+		token.Label = line[labelIdx[2]:labelIdx[3]]
+		code = line[labelIdx[1]:]
+		codeNoq = noq[labelIdx[1]:]
+	}
+
+	instrIdx := instrRe.FindStringSubmatchIndex(codeNoq)
 	if instrIdx == nil {
 		return nil, line
 	}
 
-	instr := line[instrIdx[2]:instrIdx[3]]
-	token := InstructionToken{Instr: instr}
+	token.Instr = code[instrIdx[2]:instrIdx[3]]
 
-	rest := line[instrIdx[3]:]
-	args := strings.Split(rest, ",")
+	token.RawArgs = strings.TrimSpace(code[instrIdx[3]:])
+	token.Args = SplitOperands(code[instrIdx[3]:])
+	return token, ""
+}
 
-	for i, arg := range args {
-		args[i] = strings.TrimSpace(arg)
+// SplitOperands splits an operand list, or the values of a data definition, on
+// its top-level commas and trims each operand. Commas inside of quotes, a
+// memory reference's brackets, or the braces and parentheses used to group
+// macro arguments don't split operands.
+func SplitOperands(s string) []string {
+	var args []string
+	var depth int
+	var start int
+
+	// NoQuotes masks quotes rune by rune, so work in runes to keep the indices
+	// of the mask and the original string in sync.
+	sr := []rune(s)
+	noq := []rune(NoQuotes(s, "x"))
+
+	for i := range noq {
+		switch noq[i] {
+		case '[', '{', '(':
+			depth++
+		case ']', '}', ')':
+			if depth > 0 {
+				depth--
+			}
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(string(sr[start:i])))
+				start = i + 1
+			}
+		case '\t':
+			// Tabs within an operand, e.g. "[rax\t+\t4]", would be taken as
+			// column separators once formatted. Quoted ones are masked, so
+			// string data is kept as it is.
+			sr[i] = ' '
+		}
 	}
 
-	token.Args = args
-	return token, ""
+	return append(args, strings.TrimSpace(string(sr[start:])))
 }
 
 func (t InstructionToken) String() string {
 	s := t.Instr
-	if len(t.Args) > 0 {
+	if t.KeepOperands {
+		s += "\t"
+		s += t.RawArgs
+	} else if len(t.Args) > 0 {
 		s += "\t"
 		s += strings.Join(t.Args, ", ")
 	}
+	if t.Label != "" {
+		s = t.Label + ":\t" + s
+	}
 	return s
 }
 
 type SectionToken struct {
 	Keyword string
 	Name    string
+	// Attributes is the whitespace-collapsed list of attributes after the
+	// name, e.g. "progbits alloc exec", or "" if there are none.
+	Attributes string
 }
 
-// sectionRe matches whole line.
-var sectionRe = regexp.MustCompile(`^(?i)\s*(section|segment)\s+([^;\s]*)\s*$`)
+var sectionKeywords = keywordSet([]string{"section", "segment"})
+
+// sectionRe matches whole line. The trailing comment has already been taken
+// off by ParseCommentToken, so everything after the name is attributes.
+var sectionRe = regexp.MustCompile(`^(?i)\s*(section|segment)\s+([^;\s]*)(?:\s+([^;]*?))?\s*$`)
 
 func ParseSectionToken(parser *Parser, line string) (Token, string) {
+	if !hasLeadingKeyword(line, 1, sectionKeywords) {
+		return nil, line
+	}
+
 	noq := NoQuotes(line, "x")
 
 	ind := sectionRe.FindStringSubmatchIndex(noq)
@@ -158,13 +315,21 @@ func ParseSectionToken(parser *Parser, line string) (Token, string) {
 		return nil, line
 	}
 
-	return SectionToken{
+	section := SectionToken{
 		Keyword: line[ind[2]:ind[3]],
 		Name:    line[ind[4]:ind[5]],
-	}, ""
+	}
+	if ind[6] != -1 {
+		section.Attributes = CollapseSpace(line[ind[6]:ind[7]])
+	}
+
+	return section, ""
 }
 
 func (t SectionToken) String() string {
+	if t.Attributes != "" {
+		return t.Keyword + " " + t.Name + " " + t.Attributes
+	}
 	return t.Keyword + " " + t.Name
 }
 
@@ -176,10 +341,15 @@ var directiveKeywords = []string{
 	"common",
 	"cpu",
 	"float",
+	"export",
+	"import",
+	"group",
 }
 
+var directiveKeywordSet = keywordSet(directiveKeywords)
+
 var directiveRe = regexp.MustCompile(fmt.Sprintf(
-	`^(?i)\s*(%s)\s+([^;\s]*)\s*$`,
+	`^(?i)\s*(%s)\s+([^;]*?)\s*$`,
 	strings.Join(directiveKeywords, "|"),
 ))
 
@@ -189,6 +359,10 @@ type DirectiveToken struct {
 }
 
 func ParseDirectiveToken(parser *Parser, line string) (Token, string) {
+	if !hasLeadingKeyword(line, 1, directiveKeywordSet) {
+		return nil, line
+	}
+
 	noq := NoQuotes(line, "x")
 
 	ind := directiveRe.FindStringSubmatchIndex(noq)
@@ -198,10 +372,50 @@ func ParseDirectiveToken(parser *Parser, line string) (Token, string) {
 
 	return DirectiveToken{
 		Keyword: line[ind[2]:ind[3]],
-		Text:    line[ind[4]:ind[5]],
+		Text:    CollapseSpace(line[ind[4]:ind[5]]),
 	}, ""
 }
 
+// CollapseSpace collapses each run of whitespace outside of quotes in s into a
+// single space, and trims the whitespace around s.
+func CollapseSpace(s string) string {
+	sr := []rune(s)
+	noq := []rune(NoQuotes(s, "x"))
+
+	var b strings.Builder
+	var space bool
+
+	for i, r := range noq {
+		if unicode.IsSpace(r) {
+			space = true
+			continue
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+		b.WriteRune(sr[i])
+	}
+
+	return b.String()
+}
+
+// untab replaces the tabs outside of quotes in s with spaces.
+func untab(s string) string {
+	if !strings.ContainsRune(s, '\t') {
+		return s
+	}
+
+	sr := []rune(s)
+	for i, r := range []rune(NoQuotes(s, "x")) {
+		if r == '\t' {
+			sr[i] = ' '
+		}
+	}
+
+	return string(sr)
+}
+
 func (t DirectiveToken) String() string {
 	return t.Keyword + " " + t.Text
 }
@@ -218,18 +432,46 @@ var pseudoKeywords = []string{
 	"times",
 }
 
+var pseudoKeywordSet = keywordSet(pseudoKeywords)
+
+// PseudoKeywords returns the lowercase keywords of the pseudo-instructions
+// that are parsed as a PseudoToken.
+func PseudoKeywords() []string {
+	return append([]string(nil), pseudoKeywords...)
+}
+
+// pseudoRe matches a pseudo-instruction at the start of the line, optionally
+// preceded by a single label. Anything else before the keyword means that the
+// keyword is just an argument of some other statement, e.g. the "db" in
+// "alignb 16, db 0".
 var pseudoRe = regexp.MustCompile(fmt.Sprintf(
-	`(?i)(.*?):?(?:\s|^)(%s)(?:\s|")`,
+	`(?i)^\s*(?:(%s)(?::\s*|\s+))?(%s)(?:\s|")`,
+	labelPattern, strings.Join(pseudoKeywords, "|"),
+))
+
+// timesInnerRe matches the pseudo-instruction repeated by a times prefix.
+var timesInnerRe = regexp.MustCompile(fmt.Sprintf(
+	`(?i)(?:\s|^)(%s)(?:\s|"|$)`,
 	strings.Join(pseudoKeywords, "|"),
 ))
 
 type PseudoToken struct {
 	Label string
+	// Times is the times keyword as written if the pseudo-instruction is
+	// prefixed by one, e.g. "times" in "times 8 db 0". Count is then the
+	// count expression, e.g. "8".
+	Times string
+	Count string
 	Instr string
 	Text  string
 }
 
 func ParsePseudoToken(parser *Parser, line string) (Token, string) {
+	// The keyword is either the first word or the one after the label.
+	if !hasLeadingKeyword(line, 2, pseudoKeywordSet) {
+		return nil, line
+	}
+
 	noq := NoQuotes(line, "x")
 
 	idx := pseudoRe.FindStringSubmatchIndex(noq)
@@ -237,55 +479,197 @@ func ParsePseudoToken(parser *Parser, line string) (Token, string) {
 		return nil, line
 	}
 
-	return PseudoToken{
-		Label: strings.TrimSpace(line[idx[2]:idx[3]]),
+	token := PseudoToken{
 		Instr: line[idx[4]:idx[5]],
-		Text:  strings.TrimSpace(line[idx[5]:]),
-	}, ""
+		Text:  untab(strings.TrimSpace(line[idx[5]:])),
+	}
+	if idx[2] != -1 {
+		token.Label = line[idx[2]:idx[3]]
+	}
+
+	if strings.EqualFold(token.Instr, "times") {
+		token = splitTimes(token)
+	}
+
+	return token, ""
+}
+
+// splitTimes splits the times prefix of the token from the pseudo-instruction
+// that it repeats. The token is returned as-is if the times prefix doesn't
+// repeat a pseudo-instruction, e.g. in "times 4 nop".
+func splitTimes(token PseudoToken) PseudoToken {
+	text := token.Text
+	idx := timesInnerRe.FindStringSubmatchIndex(NoQuotes(text, "x"))
+	if idx == nil {
+		return token
+	}
+
+	count := strings.TrimSpace(text[:idx[2]])
+	if count == "" {
+		return token
+	}
+
+	return PseudoToken{
+		Label: token.Label,
+		Times: token.Instr,
+		Count: count,
+		Instr: text[idx[2]:idx[3]],
+		Text:  strings.TrimSpace(text[idx[3]:]),
+	}
 }
 
+// String returns the token with its label, keyword and text separated by tabs.
+// The times prefix takes the place of the keyword, with the count and the
+// pseudo-instruction that it repeats at the start of the text, so that it
+// doesn't widen the keyword column of the lines around it.
 func (t PseudoToken) String() string {
-	return t.Label + "\t" + t.Instr + "\t" + t.Text
+	if t.Times == "" {
+		return t.Label + "\t" + t.Instr + "\t" + t.Text
+	}
+
+	text := t.Count + " " + t.Instr
+	if t.Text != "" {
+		text += " " + t.Text
+	}
+	return t.Label + "\t" + t.Times + "\t" + text
 }
 
 type CommentToken struct {
 	Comment string
+	// Raw is the comment exactly as it was written, semicolon included.
+	Raw string
+	// Column is the column that the comment's semicolon was at in the
+	// original line, with tabs expanded to every TabWidth columns.
+	Column int
+	// Gap is the number of columns of whitespace between the comment and the
+	// code before it in the original line, or 0 if there's no code before it.
+	Gap int
 }
 
+// TabWidth is the width of a tab when computing the original column of a
+// token.
+const TabWidth = 8
+
 func ParseCommentToken(parser *Parser, line string) (Token, string) {
 	noq := NoQuotes(line, "x")
 
-	idx := strings.Index(noq, ";")
-	if idx == -1 {
+	ridx := strings.IndexRune(noq, ';')
+	if ridx == -1 {
 		return nil, line
 	}
 
+	// NoQuotes masks rune by rune, so the byte index of the semicolon in noq
+	// may not be the same as in line.
+	idx := len(string([]rune(line)[:utf8.RuneCountInString(noq[:ridx])]))
+
 	cmt := line[idx+1:]
 	if cmt != " " {
 		cmt = strings.TrimPrefix(cmt, " ")
 	}
 
-	return CommentToken{cmt}, line[:idx]
+	token := CommentToken{
+		Comment: cmt,
+		Raw:     line[idx:],
+		Column:  columnWidth(line[:idx]),
+	}
+
+	if code := strings.TrimRightFunc(line[:idx], unicode.IsSpace); strings.TrimSpace(code) != "" {
+		token.Gap = token.Column - columnWidth(code)
+	}
+
+	return token, line[:idx]
+}
+
+// columnWidth returns the number of columns that s takes up, with tabs
+// expanded to every TabWidth columns.
+func columnWidth(s string) int {
+	var w int
+	for _, r := range s {
+		if r == '\t' {
+			w += TabWidth - w%TabWidth
+		} else {
+			w++
+		}
+	}
+	return w
 }
 
 func (t CommentToken) String() string {
 	return "; " + t.Comment
 }
 
+// MacroToken is a preprocessor directive. Everything after its percent sign
+// is kept exactly as written, so that parameter specs such as the "1-3",
+// "1+" or "1-2 0" of %macro are never reformatted. As in NASM, a semicolon
+// outside of quotes starts a comment even in the body of a %define, so the
+// comment is already taken off by ParseCommentToken by the time the directive
+// is parsed.
 type MacroToken struct {
 	Macro string
 }
 
+// spacedKeywords are the preprocessor directives whose arguments are simple
+// enough that the space after their keyword is normalized: the ones that
+// compute a string at build time, %use, whose argument is the name of a
+// standard macro package such as altreg or fp, and %pathsearch, which defines
+// a macro with the path of a file found in the include path. Their arguments
+// are still kept as written, quoted file names included.
+var spacedKeywords = keywordSet([]string{"strlen", "substr", "strcat", "use", "pathsearch"})
+
+// localLabelRe matches a line starting with a macro-local or context-local
+// label, e.g. "%%loop:" or "%$done: ret".
+var localLabelRe = regexp.MustCompile(`^\s*%[%$]` + labelPattern[len(`(?:%%|%\$+)?`):] + `:`)
+
+// ParseMacroToken parses any line starting with a percent sign, whether or not
+// it's a known directive, so that lines starting with preprocessor tokens such
+// as %{1} or %+ are kept as written instead of being parsed as instructions.
+// Lines starting with a macro-local or context-local label are left to the
+// label and instruction parsers.
 func ParseMacroToken(parser *Parser, line string) (Token, string) {
 	cleanLine := strings.TrimSpace(line)
-	if !strings.HasPrefix(cleanLine, "%") {
+	if !strings.HasPrefix(cleanLine, "%") || localLabelRe.MatchString(NoQuotes(line, "x")) {
 		return nil, line
 	}
-	return MacroToken{
+
+	token := MacroToken{
 		Macro: strings.TrimPrefix(cleanLine, "%"),
-	}, ""
+	}
+
+	kw := token.Keyword()
+	if _, ok := spacedKeywords[kw]; ok {
+		args := strings.TrimLeftFunc(token.Macro[len(kw):], unicode.IsSpace)
+		if args != "" {
+			token.Macro = token.Macro[:len(kw)] + " " + args
+		}
+	}
+
+	return token, ""
+}
+
+// Keyword returns the lowercased name of the preprocessor directive, e.g.
+// "ifdef" for "%ifdef FOO".
+func (t MacroToken) Keyword() string {
+	end := strings.IndexFunc(t.Macro, unicode.IsSpace)
+	if end == -1 {
+		end = len(t.Macro)
+	}
+	return strings.ToLower(t.Macro[:end])
 }
 
 func (t MacroToken) String() string {
 	return "%" + t.Macro
 }
+
+// ContinuationToken is a line that continues the statement on the line before
+// it, which ended with a backslash. It's never returned by the TokenParsers, as
+// only the parser knows whether the line before it was continued.
+type ContinuationToken struct {
+	// Indent is the column that the text started at in the original line,
+	// with tabs expanded to every TabWidth columns.
+	Indent int
+	Text   string
+}
+
+func (t ContinuationToken) String() string {
+	return t.Text
+}
@@ -0,0 +1,164 @@
+package nasm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func parseOne(t *testing.T, src string) Line {
+	t.Helper()
+
+	lines, err := Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal("cannot parse:", err)
+	}
+	if len(lines) != 1 {
+		t.Fatalf("got %d lines, want 1", len(lines))
+	}
+	return lines[0]
+}
+
+func TestDefineComment(t *testing.T) {
+	line := parseOne(t, "%define X 1 ; two\n")
+
+	macro, ok := line.Token.(MacroToken)
+	if !ok {
+		t.Fatalf("got token %#v, want a MacroToken", line.Token)
+	}
+	if macro.Macro != "define X 1" {
+		t.Errorf("macro = %q, want %q", macro.Macro, "define X 1")
+	}
+	if line.Comment.Comment != "two" {
+		t.Errorf("comment = %q, want %q", line.Comment.Comment, "two")
+	}
+}
+
+func TestCollapseSpace(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"  a  b\t c ", "a b c"},
+		{"a\t'x  \ty'  b", "a 'x  \ty' b"},
+		{`.data   progbits  align=4`, `.data progbits align=4`},
+	}
+
+	for _, test := range tests {
+		if got := CollapseSpace(test.s); got != test.want {
+			t.Errorf("CollapseSpace(%q) = %q, want %q", test.s, got, test.want)
+		}
+	}
+}
+
+func TestSplitOperands(t *testing.T) {
+	tests := []struct {
+		s    string
+		want []string
+	}{
+		{"eax, 1", []string{"eax", "1"}},
+		{"eax, [ebx + ecx*4, 8]", []string{"eax", "[ebx + ecx*4, 8]"}},
+		{`"a, b", 0`, []string{`"a, b"`, "0"}},
+		{"{1, 2}, (3, 4)", []string{"{1, 2}", "(3, 4)"}},
+	}
+
+	for _, test := range tests {
+		if got := SplitOperands(test.s); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("SplitOperands(%q) = %q, want %q", test.s, got, test.want)
+		}
+	}
+}
+
+func TestInstructionOperands(t *testing.T) {
+	tests := []struct {
+		src  string
+		args []string
+	}{
+		{"\tmov eax, [base + idx]\n", []string{"eax", "[base + idx]"}},
+		{"\tmov eax, [ebx+ecx*4]\n", []string{"eax", "[ebx+ecx*4]"}},
+		{"\tmymacro {1, 2}, eax\n", []string{"{1, 2}", "eax"}},
+		{"\tpush qword (1, 2)\n", []string{"qword (1, 2)"}},
+		{"\tmov al, ','\n", []string{"al", "','"}},
+	}
+
+	for _, test := range tests {
+		line := parseOne(t, test.src)
+
+		instr, ok := line.Token.(InstructionToken)
+		if !ok {
+			t.Errorf("%q: got token %#v, want an InstructionToken", test.src, line.Token)
+			continue
+		}
+		if !reflect.DeepEqual(instr.Args, test.args) {
+			t.Errorf("%q: got operands %q, want %q", test.src, instr.Args, test.args)
+		}
+	}
+}
+
+func TestLinesClone(t *testing.T) {
+	lines, err := Parse(strings.NewReader("\tmov eax, 1\nx:\tdb 1, 2\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clone := lines.Clone()
+	if !reflect.DeepEqual(clone, lines) {
+		t.Fatal("clone differs from the lines")
+	}
+
+	clone[0].Token.(InstructionToken).Args[0] = "ebx"
+	if got := lines[0].Token.(InstructionToken).Args[0]; got != "eax" {
+		t.Errorf("changing the clone changed the lines: operand is %q", got)
+	}
+}
+
+func TestLineContinues(t *testing.T) {
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{"%define EMPTY \\", true},
+		{"\tmov eax, \\", true},
+		{"\tmov eax, 1", false},
+		{"\tmov eax, 1 ; \\", false},
+		{"\tdb '\\'", false},
+		{"; \\", false},
+	}
+
+	for _, test := range tests {
+		if got := parseOne(t, test.src).Continues(); got != test.want {
+			t.Errorf("%q: Continues() = %v, want %v", test.src, got, test.want)
+		}
+	}
+}
+
+func TestLeadingKeyword(t *testing.T) {
+	long := "\tdb " + strings.Repeat("0x00, ", 1<<17) + "0\n"
+
+	tests := []struct {
+		src  string
+		want Token
+	}{
+		{"section .text\n", SectionToken{}},
+		{"\tSEGMENT code\n", SectionToken{}},
+		{"\tmov eax, section\n", InstructionToken{}},
+		{"global main\n", DirectiveToken{}},
+		{"\tcall global\n", InstructionToken{}},
+		{"msg: db \"hi\", 0\n", PseudoToken{}},
+		{"msg db 'db'\n", PseudoToken{}},
+		{"\tmov al, 'db'\n", InstructionToken{}},
+		{long, PseudoToken{}},
+	}
+
+	for _, test := range tests {
+		src := test.src
+		if len(src) > 40 {
+			src = src[:40] + "..."
+		}
+
+		got := parseOne(t, test.src).Token
+		if reflect.TypeOf(got) != reflect.TypeOf(test.want) {
+			t.Errorf("%q: got token %T, want %T", src, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package nasmfmt
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func BenchmarkFormat(b *testing.B) {
+	srcs := readBench(b)
+
+	names := make([]string, 0, len(srcs))
+	for name := range srcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		src := srcs[name]
+
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(src)))
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if err := Format(io.Discard, strings.NewReader(src), testConfig); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkFormatLongLines formats generated data made of very long db lines.
+func BenchmarkFormatLongLines(b *testing.B) {
+	var src strings.Builder
+	for i := 0; i < 16; i++ {
+		src.WriteString("data: db ")
+		src.WriteString(strings.Repeat("0x00, ", 1<<14))
+		src.WriteString("0\n")
+	}
+
+	b.SetBytes(int64(src.Len()))
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		if err := Format(io.Discard, strings.NewReader(src.String()), testConfig); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
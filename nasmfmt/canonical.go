@@ -0,0 +1,72 @@
+package nasmfmt
+
+import (
+	"io"
+	"strings"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// CanonicalConfig returns the config for the canonical form of a file: every
+// keyword and mnemonic lowercased, each column separated by a single space and
+// no blank lines. It isn't meant to be read, but to be compared or hashed, so
+// that two files that only differ in their formatting have the same canonical
+// form. Comments are kept unless StripComments is set on the returned config.
+func CanonicalConfig() FormatConfig {
+	return FormatConfig{
+		LabelSeparator:   LabelSeparatorSpace,
+		PseudoCase:       CaseLower,
+		InstructionCase:  CaseLower,
+		DirectiveCase:    CaseLower,
+		Target:           TargetAuto,
+		CommentNormalize: CommentNormalizePreserveEmpty,
+		Compact:          true,
+
+		BlankLinesBeforeSection: NoBlankLines,
+		BlankLinesAfterSection:  NoBlankLines,
+	}
+}
+
+// stripComments returns the lines without their comments. Lines that only had
+// a comment are dropped, so they don't turn into blank lines that would split
+// their block in two, unless they end a continued statement, which a blank
+// line then has to end instead.
+func stripComments(lines nasm.Lines) nasm.Lines {
+	stripped := make(nasm.Lines, 0, len(lines))
+
+	for i, line := range lines {
+		if line.Token == nil && line.Comment != (nasm.CommentToken{}) {
+			if i > 0 && lines[i-1].Continues() {
+				stripped = append(stripped, nasm.Line{SourceLine: line.SourceLine})
+			}
+			continue
+		}
+		line.Comment = nasm.CommentToken{}
+		stripped = append(stripped, line)
+	}
+
+	return stripped
+}
+
+// writeCompactBlock writes the rendered lines of the block with a single space
+// between their columns and before their comments.
+func writeCompactBlock(dst io.Writer, block nasm.Lines, lines []string, cfg FormatConfig) error {
+	var out strings.Builder
+
+	for i, s := range lines {
+		s = nasm.CollapseSpace(s)
+
+		if comment := block[i].Comment; comment != (nasm.CommentToken{}) {
+			if s != "" {
+				s += " "
+			}
+			s += cfg.CommentNormalize.Apply(comment)
+		}
+
+		out.WriteString(s)
+		out.WriteByte('\n')
+	}
+
+	_, err := io.WriteString(dst, out.String())
+	return err
+}
@@ -0,0 +1,73 @@
+package nasmfmt
+
+import "testing"
+
+func TestCanonicalSpacing(t *testing.T) {
+	const a = "" +
+		"section .text\n" +
+		"global _start\n" +
+		"_start:\n" +
+		"\tMOV eax,1 ; one\n" +
+		"\tmov    ebx, [ecx+4]\n" +
+		"\n\n" +
+		"\tret\n"
+
+	const b = "" +
+		"section   .text\n" +
+		"\n" +
+		"global   _start\n" +
+		"\n" +
+		"_start:\n" +
+		"mov eax , 1 ;one\n" +
+		"        mov ebx,[ecx+4]\n" +
+		"RET\n"
+
+	const want = "" +
+		"section .text\n" +
+		"global _start\n" +
+		"_start:\n" +
+		"mov eax, 1 ; one\n" +
+		"mov ebx, [ecx+4]\n" +
+		"ret\n"
+
+	cfg := CanonicalConfig()
+
+	if got := assertStable(t, a, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if got := assertStable(t, b, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestCanonicalContinuation(t *testing.T) {
+	tests := []struct {
+		name  string
+		strip bool
+		src   string
+		want  string
+	}{
+		{
+			name: "blank line",
+			src:  "%define EMPTY \\\n\n\tmov eax, 1\n",
+			want: "%define EMPTY \\\n\nmov eax, 1\n",
+		},
+		{
+			name:  "stripped comment",
+			strip: true,
+			src:   "%define EMPTY \\\n; nothing\n\tmov eax, 1\n",
+			want:  "%define EMPTY \\\n\nmov eax, 1\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := CanonicalConfig()
+			cfg.StripComments = test.strip
+
+			if got := assertStable(t, test.src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
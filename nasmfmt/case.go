@@ -0,0 +1,56 @@
+package nasmfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Case is the letter case that keywords are normalized to.
+type Case uint8
+
+const (
+	// CaseKeep keeps keywords in the case they were written in.
+	CaseKeep Case = iota
+	// CaseLower lowercases keywords.
+	CaseLower
+	// CaseUpper uppercases keywords.
+	CaseUpper
+)
+
+var caseNames = []string{
+	CaseKeep:  "keep",
+	CaseLower: "lower",
+	CaseUpper: "upper",
+}
+
+// ParseCase parses the name of a Case, which is one of "keep", "lower" or
+// "upper".
+func ParseCase(name string) (Case, error) {
+	for c, caseName := range caseNames {
+		if caseName == name {
+			return Case(c), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown case %q", name)
+}
+
+// String returns the name of the case.
+func (c Case) String() string {
+	if int(c) < len(caseNames) {
+		return caseNames[c]
+	}
+	return fmt.Sprintf("Case(%d)", c)
+}
+
+// Apply returns s in the case. Go's case mapping doesn't depend on the
+// locale, so an "i" is never turned into a dotted İ.
+func (c Case) Apply(s string) string {
+	switch c {
+	case CaseLower:
+		return strings.ToLower(s)
+	case CaseUpper:
+		return strings.ToUpper(s)
+	default:
+		return s
+	}
+}
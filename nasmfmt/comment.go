@@ -0,0 +1,123 @@
+package nasmfmt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// CommentNormalize determines whether comments are written with a space after
+// their semicolon.
+type CommentNormalize uint8
+
+const (
+	// CommentNormalizeAlways writes every comment with a single space after
+	// its semicolon, even an empty one.
+	CommentNormalizeAlways CommentNormalize = iota
+	// CommentNormalizeNever writes every comment exactly as it was written
+	// after its semicolon, so ";text" and "; text" are both kept.
+	CommentNormalizeNever
+	// CommentNormalizePreserveEmpty writes comments with a single space after
+	// their semicolon like CommentNormalizeAlways, except for empty comments,
+	// which are written as a bare semicolon.
+	CommentNormalizePreserveEmpty
+)
+
+var commentNormalizeNames = []string{
+	CommentNormalizeAlways:        "always",
+	CommentNormalizeNever:         "never",
+	CommentNormalizePreserveEmpty: "preserve-empty",
+}
+
+// ParseCommentNormalize parses the name of a CommentNormalize mode, which is
+// one of "always", "never" or "preserve-empty".
+func ParseCommentNormalize(name string) (CommentNormalize, error) {
+	for mode, modeName := range commentNormalizeNames {
+		if modeName == name {
+			return CommentNormalize(mode), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown comment normalization %q", name)
+}
+
+// String returns the name of the comment normalization mode.
+func (n CommentNormalize) String() string {
+	if int(n) < len(commentNormalizeNames) {
+		return commentNormalizeNames[n]
+	}
+	return fmt.Sprintf("CommentNormalize(%d)", n)
+}
+
+// Apply returns the comment, semicolon included, normalized according to the
+// mode.
+func (n CommentNormalize) Apply(comment nasm.CommentToken) string {
+	switch n {
+	case CommentNormalizeNever:
+		if comment.Raw != "" {
+			return comment.Raw
+		}
+	case CommentNormalizePreserveEmpty:
+		if strings.TrimSpace(comment.Comment) == "" {
+			return ";"
+		}
+	}
+	return comment.String()
+}
+
+// commentColumnSlack is how many columns apart comments can be and still be
+// taken as being in the same column.
+const commentColumnSlack = 2
+
+// commentsFormColumn returns true if most of the comments after code in the
+// block are at about the same column in the source, and either there's more
+// than one of them or that column is the one that comments are aligned to.
+// Comments a single space after their code are left out, being where both
+// ways of formatting put comments after code too long to align, so that the
+// output is taken the same way when formatted again.
+func commentsFormColumn(block nasm.Lines, column int) bool {
+	var columns []int
+	for _, line := range block {
+		if hasAlignedComment(line) && line.Comment.Gap > 1 {
+			columns = append(columns, line.Comment.Column)
+		}
+	}
+
+	for _, col := range columns {
+		var near int
+		for _, other := range columns {
+			if other >= col && other-col <= commentColumnSlack {
+				near++
+			}
+		}
+		if (near > 1 || col == column) && near*2 > len(columns) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasAlignedComment returns true if the line has code with a comment after it
+// that's aligned to CommentIndent.
+func hasAlignedComment(line nasm.Line) bool {
+	if line.Comment == (nasm.CommentToken{}) {
+		return false
+	}
+	switch line.Token.(type) {
+	case nasm.InstructionToken, nasm.ContinuationToken, nasm.DirectiveToken, nasm.PseudoToken:
+		return true
+	default:
+		return false
+	}
+}
+
+// commentColumn returns the column that comments after code are aligned to,
+// unless their code runs past it.
+func commentColumn(cfg FormatConfig) int {
+	col := cfg.CommentIndent - 1
+	if tw := cfg.CommentTabWidth; tw > 0 && col%tw != 0 {
+		col += tw - col%tw
+	}
+	return col
+}
@@ -0,0 +1,283 @@
+package nasmfmt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKeepScatteredComments(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "scattered",
+			src: "" +
+				"\tmov eax, 1 ; a\n" +
+				"\tmov ebx, 2                  ; b\n" +
+				"\tmov ecx, 3      ; c\n",
+			want: "" +
+				"        mov eax, 1 ; a\n" +
+				"        mov ebx, 2 ; b\n" +
+				"        mov ecx, 3 ; c\n",
+		},
+		{
+			name: "column",
+			src: "" +
+				"\tmov eax, 1                 ; a\n" +
+				"\tmov ebx, 2                  ; b\n" +
+				"\tmov ecx, 3 ; c\n",
+			want: "" +
+				"        mov eax, 1                     ; a\n" +
+				"        mov ebx, 2                     ; b\n" +
+				"        mov ecx, 3                     ; c\n",
+		},
+		{
+			name: "single aligned",
+			src: "" +
+				"\tmov eax, 1                     ; a\n" +
+				"\tmov ebx, 2\n",
+			want: "" +
+				"        mov eax, 1                     ; a\n" +
+				"        mov ebx, 2\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			cfg.KeepScatteredComments = true
+
+			if got := assertStable(t, test.src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestKeepScatteredCommentsStable(t *testing.T) {
+	cfg := testConfig
+	cfg.KeepScatteredComments = true
+
+	for name, src := range readBench(t) {
+		t.Run(name, func(t *testing.T) {
+			assertStable(t, src, cfg)
+		})
+	}
+}
+
+func TestMaxLineWidth(t *testing.T) {
+	const src = "" +
+		"foo:\n" +
+		"\tmov eax, [ebx+ecx*4+8] ; a rather long comment that goes on\n" +
+		"\tmov ebx, 1 ; short\n" +
+		"\tmov ecx, 2\n" +
+		"; stays at column zero\n" +
+		"\tret\n"
+
+	const want = "" +
+		"foo:\n" +
+		"        ; a rather long comment that goes on\n" +
+		"        mov eax, [ebx+ecx*4+8]\n" +
+		"        mov ebx, 1                     ; short\n" +
+		"        mov ecx, 2\n" +
+		"; stays at column zero\n" +
+		"        ret\n"
+
+	cfg := testConfig
+	cfg.MaxLineWidth = 60
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMaxLineWidthContinuation(t *testing.T) {
+	const src = "" +
+		"%define LIST a, \\\n" +
+		"\tb ; this is a rather long trailing comment here\n" +
+		"\tmov eax, 1 ; this is a rather long trailing comment here\n"
+
+	// Moving the first comment onto a line of its own would make it part of
+	// the %define.
+	const want = "" +
+		"%define LIST a, \\\n" +
+		"        b                              ; this is a rather long trailing comment here\n" +
+		"        ; this is a rather long trailing comment here\n" +
+		"        mov eax, 1\n"
+
+	cfg := testConfig
+	cfg.MaxLineWidth = 40
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDividerComments(t *testing.T) {
+	const src = "" +
+		";---\n" +
+		"\tret\n" +
+		";--------\n" +
+		"; text\n"
+
+	tests := []struct {
+		name string
+		cfg  FormatConfig
+		want string
+	}{
+		{
+			name: "width and char",
+			cfg: FormatConfig{
+				InstructionIndent: 8,
+				DividerWidth:      40,
+				DividerChar:       '=',
+			},
+			want: "" +
+				";" + strings.Repeat("=", 39) + "\n" +
+				"        ret\n" +
+				";" + strings.Repeat("=", 39) + "\n" +
+				"; text\n",
+		},
+		{
+			name: "width",
+			cfg:  FormatConfig{InstructionIndent: 8, DividerWidth: 20},
+			want: "" +
+				";" + strings.Repeat("-", 19) + "\n" +
+				"        ret\n" +
+				";" + strings.Repeat("-", 19) + "\n" +
+				"; text\n",
+		},
+		{
+			name: "off",
+			cfg:  FormatConfig{},
+			want: "" +
+				";---\n" +
+				"ret\n" +
+				";--------\n" +
+				"; text\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := assertStable(t, src, test.cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCommentNormalize(t *testing.T) {
+	const src = "" +
+		";text\n" +
+		";\n" +
+		";   spaced\n" +
+		"\tret ;x\n"
+
+	tests := []struct {
+		mode CommentNormalize
+		want string
+	}{
+		{
+			mode: CommentNormalizeAlways,
+			want: "" +
+				"; text\n" +
+				";\n" +
+				";   spaced\n" +
+				"        ret                            ; x\n",
+		},
+		{
+			mode: CommentNormalizeNever,
+			want: "" +
+				";text\n" +
+				";\n" +
+				";   spaced\n" +
+				"        ret                            ;x\n",
+		},
+		{
+			mode: CommentNormalizePreserveEmpty,
+			want: "" +
+				"; text\n" +
+				";\n" +
+				";   spaced\n" +
+				"        ret                            ; x\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mode.String(), func(t *testing.T) {
+			cfg := testConfig
+			cfg.CommentNormalize = test.mode
+
+			if got := assertStable(t, src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCommentTabWidth(t *testing.T) {
+	const src = "" +
+		"\tnop ; a\n" +
+		"\n" +
+		"\tmov eax, 1 ; b\n" +
+		"\n" +
+		"\tmovzx eax, byte [rsi + 12345678] ; c\n" +
+		"\n" +
+		"\tmov al, 1 ; d\n" +
+		"\tmovzx eax, byte [rsi+123] ; e\n"
+
+	tests := []struct {
+		name   string
+		indent int
+		want   string
+	}{
+		{
+			name:   "unaligned",
+			indent: 0,
+			want: "" +
+				"        nop     ; a\n" +
+				"\n" +
+				"        mov eax, 1  ; b\n" +
+				"\n" +
+				"        movzx eax, byte [rsi + 12345678]    ; c\n" +
+				"\n" +
+				"        mov   al, 1 ; d\n" +
+				"        movzx eax, byte [rsi+123]   ; e\n",
+		},
+		{
+			name:   "aligned",
+			indent: 30,
+			want: "" +
+				"        nop                     ; a\n" +
+				"\n" +
+				"        mov eax, 1              ; b\n" +
+				"\n" +
+				"        movzx eax, byte [rsi + 12345678]    ; c\n" +
+				"\n" +
+				"        mov   al, 1             ; d\n" +
+				"        movzx eax, byte [rsi+123]   ; e\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			cfg.CommentIndent = test.indent
+			cfg.CommentTabWidth = 4
+
+			got := assertStable(t, src, cfg)
+			if got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+
+			for _, line := range strings.Split(got, "\n") {
+				if col := strings.IndexByte(line, ';'); col > 0 && col%4 != 0 {
+					t.Errorf("comment at column %d, not on a tab stop: %q", col, line)
+				}
+			}
+		})
+	}
+}
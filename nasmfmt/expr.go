@@ -0,0 +1,110 @@
+package nasmfmt
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// exprOperators are the operators of NASM expressions that are spaced out,
+// longest first so that "<<" is never taken for two "<". The modulo operators
+// are missing on purpose, as a percent sign also starts preprocessor tokens
+// such as %1 or %$local.
+var exprOperators = []string{
+	"<<<", ">>>", "<=>",
+	"<<", ">>", "//", "==", "!=", "<>", "<=", ">=", "&&", "||", "^^",
+	"+", "-", "*", "/", "&", "|", "^", "<", ">", "=", "~", "!",
+}
+
+// spaceOperators returns the expression with a single space around each of its
+// binary operators and none after its unary ones, e.g. "1 << 4" for "1<<4" and
+// "-(a + b)" for "- ( a+b )". Strings and character constants are kept as they
+// are, and so are the signs of exponents in floating-point constants such as
+// "1.5e-3". $ and $$ are operands like any other, and so are identifiers
+// escaped with a leading $, e.g. "$eax - 1" rather than "$ eax - 1".
+func spaceOperators(expr string) string {
+	sr := []rune(expr)
+	noq := []rune(nasm.NoQuotes(expr, "x"))
+
+	var b strings.Builder
+	var space bool   // whitespace before the current rune
+	var operand bool // the last token written ends an operand
+	var word []rune  // the operand being written, to find exponents in
+
+	for i := 0; i < len(noq); {
+		r := noq[i]
+		op := operatorAt(noq[i:])
+
+		switch {
+		case unicode.IsSpace(r):
+			space = true
+			i++
+			continue
+
+		case r == '(' || r == ')':
+			if r == '(' && operand && space {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			operand = r == ')'
+			word = nil
+			i++
+
+		case op == "" || isExponentSign(word, r):
+			if operand && space {
+				// Two operands in a row, as in "seg foo".
+				b.WriteByte(' ')
+				word = nil
+			}
+			b.WriteRune(sr[i])
+			word = append(word, r)
+			operand = true
+			i++
+
+		default:
+			if operand && op != "~" && op != "!" {
+				b.WriteString(" " + op + " ")
+			} else {
+				b.WriteString(op)
+			}
+			operand = false
+			word = nil
+			i += len([]rune(op))
+		}
+
+		space = false
+	}
+
+	return b.String()
+}
+
+// operatorAt returns the operator that s starts with, or "" if there's none.
+func operatorAt(s []rune) string {
+	for _, op := range exprOperators {
+		if len(s) >= len(op) && string(s[:len(op)]) == op {
+			return op
+		}
+	}
+	return ""
+}
+
+// isExponentSign returns true if r is the sign of the exponent of the
+// floating-point constant that word starts, e.g. the "-" after "1.5e".
+func isExponentSign(word []rune, r rune) bool {
+	if (r != '+' && r != '-') || len(word) < 2 || !unicode.IsDigit(word[0]) {
+		return false
+	}
+
+	s := strings.ToLower(string(word))
+	hex := strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0h")
+
+	switch s[len(s)-1] {
+	case 'p':
+		return hex
+	case 'e':
+		return !hex && !strings.HasSuffix(s, "h")
+	default:
+		return false
+	}
+}
@@ -0,0 +1,61 @@
+package nasmfmt
+
+import "testing"
+
+func TestSpaceOperators(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"1<<4", "1 << 4"},
+		{"1  >>>  2", "1 >>> 2"},
+		{"- ( a+b )", "-(a + b)"},
+		{"~MASK&0xff", "~MASK & 0xff"},
+		{"$-start", "$ - start"},
+		{"$$+0x10", "$$ + 0x10"},
+		{"$eax-1", "$eax - 1"},
+		{"$-$eax", "$ - $eax"},
+		{"$$+$start*2", "$$ + $start * 2"},
+		{"'+'-'a'", "'+' - 'a'"},
+		{`"a-b",0`, `"a-b",0`},
+		{"1.5e-3*2", "1.5e-3 * 2"},
+		{"0x1p+4", "0x1p+4"},
+		{"0ah+1", "0ah + 1"},
+		{"seg foo", "seg foo"},
+	}
+
+	for _, test := range tests {
+		if got := spaceOperators(test.expr); got != test.want {
+			t.Errorf("spaceOperators(%q) = %q, want %q", test.expr, got, test.want)
+		}
+	}
+}
+
+func TestSpaceEquOperators(t *testing.T) {
+	const src = "" +
+		"FLAG_A equ 1<<4\n" +
+		"FLAG_B equ   1 <<5\n" +
+		"MASK equ FLAG_A|FLAG_B\n" +
+		"SHR equ 256>>2\n" +
+		"NEG equ -1\n" +
+		"SIZE equ $-start\n" +
+		"OFFSET equ $$+0x10\n" +
+		"CHAR equ '+'-'a'\n"
+
+	const want = "" +
+		"FLAG_A equ 1 << 4\n" +
+		"FLAG_B equ 1 << 5\n" +
+		"MASK   equ FLAG_A | FLAG_B\n" +
+		"SHR    equ 256 >> 2\n" +
+		"NEG    equ -1\n" +
+		"SIZE   equ $ - start\n" +
+		"OFFSET equ $$ + 0x10\n" +
+		"CHAR   equ '+' - 'a'\n"
+
+	cfg := testConfig
+	cfg.SpaceEquOperators = true
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,182 @@
+package nasmfmt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// Diagnostic is a suspicious line found by Analyze.
+type Diagnostic struct {
+	// Line is the 1-based source line of the suspicious line, or 0 if the line
+	// wasn't parsed from a source.
+	Line int
+	// Message describes what's suspicious about the line.
+	Message string
+}
+
+// String formats the diagnostic as "line: message".
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d: %s", d.Line, d.Message)
+}
+
+// sizeKeywords maps the size specifiers that are easily mistaken for data
+// definitions to the pseudo-instruction that was likely meant instead.
+var sizeKeywords = map[string]string{
+	"byte":  "db",
+	"word":  "dw",
+	"dword": "dd",
+	"qword": "dq",
+	"tword": "dt",
+	"oword": "do",
+}
+
+// nearMnemonics are the real mnemonics that are a single edit away from a
+// pseudo-instruction, e.g. ret and test from rest, so they're never reported
+// as typos of it.
+var nearMnemonics = map[string]bool{
+	"ret":  true,
+	"retw": true,
+	"retd": true,
+	"retq": true,
+	"test": true,
+}
+
+// Analyze returns the diagnostics for instructions in lines that are likely
+// typos of pseudo-instructions. The parser takes any unknown word as a
+// mnemonic, so "db0 1" or "rseb 16" are formatted as instructions and only
+// fail once they're assembled. It also reports tabs within operands and
+// comments, outside of quotes. Analyze never affects formatting.
+func Analyze(lines nasm.Lines) []Diagnostic {
+	var diags []Diagnostic
+
+	for _, line := range lines {
+		if strings.ContainsRune(line.Comment.Raw, '\t') {
+			diags = append(diags, Diagnostic{
+				Line:    line.SourceLine,
+				Message: "tab within comment, which makes its alignment depend on the tab width",
+			})
+		}
+
+		instr, ok := line.Token.(nasm.InstructionToken)
+		if !ok {
+			continue
+		}
+
+		if strings.ContainsRune(nasm.NoQuotes(instr.RawArgs, "x"), '\t') {
+			diags = append(diags, Diagnostic{
+				Line:    line.SourceLine,
+				Message: "tab within operands, which makes their alignment depend on the tab width",
+			})
+		}
+
+		if msg := pseudoTypo(strings.ToLower(instr.Instr)); msg != "" {
+			diags = append(diags, Diagnostic{
+				Line:    line.SourceLine,
+				Message: fmt.Sprintf("%q: %s", instr.Instr, msg),
+			})
+		}
+	}
+
+	return diags
+}
+
+// pseudoTypo returns why the lowercase mnemonic looks like a mistyped
+// pseudo-instruction, or "" if it doesn't. Only pseudo-instructions are
+// checked, as there's no complete list of mnemonics to check the others
+// against.
+func pseudoTypo(mnemonic string) string {
+	if pseudo, ok := sizeKeywords[mnemonic]; ok {
+		return fmt.Sprintf("size specifier used as an instruction, did you mean %s?", pseudo)
+	}
+
+	keywords := nasm.PseudoKeywords()
+
+	for _, kw := range keywords {
+		rest := strings.TrimPrefix(mnemonic, kw)
+		if rest != mnemonic && rest != "" && isDigit(rest[0]) {
+			return fmt.Sprintf("missing space, did you mean %s %s?", kw, rest)
+		}
+	}
+
+	// Two-letter keywords are a single letter away from many real
+	// mnemonics, e.g. db and jb.
+	if len(mnemonic) < 3 || nearMnemonics[mnemonic] {
+		return ""
+	}
+
+	for _, kw := range keywords {
+		if len(kw) >= 3 && editDistance(mnemonic, kw) == 1 {
+			return fmt.Sprintf("unknown instruction, did you mean %s?", kw)
+		}
+	}
+
+	return ""
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// editDistance returns the optimal string alignment distance between a and b:
+// the number of insertions, deletions, substitutions and transpositions of
+// adjacent bytes that turn a into b.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+func min(n int, ns ...int) int {
+	for _, m := range ns {
+		if m < n {
+			n = m
+		}
+	}
+	return n
+}
+
+// Unsupported returns the diagnostics for the lines that the formatter only
+// keeps as written because it doesn't know what they are, i.e. the lines
+// starting with a percent sign that aren't a known preprocessor directive,
+// such as "%1" or "%{1}". A file without any can be formatted with
+// confidence.
+func Unsupported(lines nasm.Lines) []Diagnostic {
+	var diags []Diagnostic
+
+	for _, line := range lines {
+		macro, ok := line.Token.(nasm.MacroToken)
+		if !ok || isKnownDirective(macro.Keyword()) {
+			continue
+		}
+
+		diags = append(diags, Diagnostic{
+			Line:    line.SourceLine,
+			Message: fmt.Sprintf("unknown preprocessor line %q", macro.String()),
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,95 @@
+package nasmfmt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+func TestPseudoTypo(t *testing.T) {
+	tests := []struct {
+		mnemonic string
+		typo     bool
+	}{
+		{"db0", true},
+		{"dw0", true},
+		{"rseb", true},
+		{"resbb", true},
+		{"byte", true},
+		{"tiems", true},
+		{"ret", false},
+		{"retq", false},
+		{"test", false},
+		{"jb", false},
+		{"mov", false},
+		// Only pseudo-instructions are known, so typos of other mnemonics
+		// are left to the assembler.
+		{"mvo", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.mnemonic, func(t *testing.T) {
+			if msg := pseudoTypo(test.mnemonic); (msg != "") != test.typo {
+				t.Errorf("pseudoTypo(%q) = %q, want a typo: %v", test.mnemonic, msg, test.typo)
+			}
+		})
+	}
+}
+
+func TestAnalyze(t *testing.T) {
+	const src = "" +
+		"\ttest eax, eax\n" +
+		"\tret\n" +
+		"\tdb0 1\n" +
+		"\tmov eax,\t1\n" +
+		"\tdw0 1\n" +
+		"\tmvo eax, 1\n"
+
+	lines, err := nasm.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, diag := range Analyze(lines) {
+		got = append(got, diag.String())
+	}
+
+	want := []string{
+		`3: "db0": missing space, did you mean db 0?`,
+		`4: tab within operands, which makes their alignment depend on the tab width`,
+		`5: "dw0": missing space, did you mean dw 0?`,
+	}
+
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	const src = "" +
+		"%$local: ret\n" +
+		"%{1}\n" +
+		"%1\n" +
+		"%define X 1\n"
+
+	lines, err := nasm.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, diag := range Unsupported(lines) {
+		got = append(got, diag.String())
+	}
+
+	want := []string{
+		`2: unknown preprocessor line "%{1}"`,
+		`3: unknown preprocessor line "%1"`,
+	}
+
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,264 @@
+package nasmfmt
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ModelineLines is the number of lines at the start of a file that are searched
+// for a modeline.
+const ModelineLines = 5
+
+// modelinePrefix is what a modeline comment starts with.
+const modelinePrefix = "nasmfmt:"
+
+// ModelineOption is a single key=value option of a modeline.
+type ModelineOption struct {
+	Key   string
+	Value string
+}
+
+// FindModeline looks for a modeline within the first ModelineLines lines of
+// src and returns its options in order. A modeline is a full-line comment
+// such as
+//
+//	; nasmfmt: ii=4 ci=32
+//
+// where each option is named after the equivalent nasmfmt flag. Options are
+// applied to a FormatConfig using SetOption.
+func FindModeline(src []byte) ([]ModelineOption, bool) {
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+
+	for i := 0; i < ModelineLines && scanner.Scan(); i++ {
+		if opts, ok := parseModeline(scanner.Text()); ok {
+			return opts, true
+		}
+	}
+
+	return nil, false
+}
+
+func parseModeline(line string) ([]ModelineOption, bool) {
+	line = strings.TrimSpace(line)
+	if !strings.HasPrefix(line, ";") {
+		return nil, false
+	}
+
+	line = strings.TrimSpace(strings.TrimPrefix(line, ";"))
+	if !strings.HasPrefix(line, modelinePrefix) {
+		return nil, false
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(line, modelinePrefix))
+	if len(fields) == 0 {
+		return nil, false
+	}
+
+	opts := make([]ModelineOption, len(fields))
+	for i, field := range fields {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			// Not a modeline, but possibly a pragma like keep-operands.
+			return nil, false
+		}
+		opts[i] = ModelineOption{key, value}
+	}
+
+	return opts, true
+}
+
+// optionKeys are the keys of the options known to SetOption.
+var optionKeys = []string{
+	"ii", "no-indent", "iu", "ci", "ao", "ac", "ls", "dw", "dc", "pc", "ic",
+	"dirc", "target", "bbs", "bas", "bbb", "bab", "pi", "cti", "ctw", "fc",
+	"untab", "cn", "mo", "asc", "at", "rn", "cols", "keep-scattered", "width",
+	"ad", "es",
+}
+
+// OptionKeys returns the keys of the options that SetOption knows.
+func OptionKeys() []string {
+	return append([]string(nil), optionKeys...)
+}
+
+// SetOption sets the option with the given key to the value. The keys are
+// named after nasmfmt's flags.
+func (cfg *FormatConfig) SetOption(key, value string) error {
+	var err error
+
+	switch key {
+	case "ii":
+		cfg.InstructionIndent, err = strconv.Atoi(value)
+	case "no-indent":
+		cfg.NoIndent, err = strconv.ParseBool(value)
+	case "iu":
+		cfg.IndentUnit, err = ParseIndentUnit(value)
+	case "ci":
+		cfg.CommentIndent, err = strconv.Atoi(value)
+	case "ao":
+		cfg.AlignOperands, err = ParseAlignOperands(value)
+	case "ac":
+		cfg.AlignCommas, err = strconv.ParseBool(value)
+	case "ls":
+		cfg.LabelSeparator, err = ParseLabelSeparator(value)
+	case "dw":
+		cfg.DividerWidth, err = strconv.Atoi(value)
+	case "dc":
+		if utf8.RuneCountInString(value) > 1 {
+			return fmt.Errorf("option dc must be a single character, got %q", value)
+		}
+		cfg.DividerChar, _ = utf8.DecodeRuneInString(value)
+		if cfg.DividerChar == utf8.RuneError {
+			cfg.DividerChar = 0
+		}
+	case "pc":
+		cfg.PseudoCase, err = ParseCase(value)
+	case "ic":
+		cfg.InstructionCase, err = ParseCase(value)
+	case "dirc":
+		cfg.DirectiveCase, err = ParseCase(value)
+	case "target":
+		cfg.Target, err = ParseTarget(value)
+	case "bbs":
+		cfg.BlankLinesBeforeSection, err = parseBlankLines(value)
+	case "bas":
+		cfg.BlankLinesAfterSection, err = parseBlankLines(value)
+	case "bbb":
+		cfg.BlankLinesBetweenBlocks, err = parseBlankLines(value)
+	case "bab":
+		cfg.BlankLinesAfterBanner, err = strconv.Atoi(value)
+	case "pi":
+		cfg.PreprocessorIndent, err = strconv.Atoi(value)
+	case "cti":
+		cfg.ContinuationIndent, err = strconv.Atoi(value)
+	case "ctw":
+		cfg.CommentTabWidth, err = strconv.Atoi(value)
+	case "fc":
+		var format bool
+		format, err = strconv.ParseBool(value)
+		cfg.VerbatimComments = !format
+	case "untab":
+		cfg.UntabComments, err = strconv.ParseBool(value)
+	case "cn":
+		cfg.CommentNormalize, err = ParseCommentNormalize(value)
+	case "mo":
+		cfg.MaxOperands, err = strconv.Atoi(value)
+	case "asc":
+		cfg.AlignSectionComments, err = strconv.ParseBool(value)
+	case "at":
+		cfg.AlignTimes, err = strconv.ParseBool(value)
+	case "rn":
+		cfg.RightAlignNumbers, err = strconv.ParseBool(value)
+	case "cols":
+		cfg.FixedColumns, err = ParseColumns(value)
+	case "keep-scattered":
+		cfg.KeepScatteredComments, err = strconv.ParseBool(value)
+	case "width":
+		cfg.MaxLineWidth, err = strconv.Atoi(value)
+	case "ad":
+		cfg.AlignDefines, err = strconv.ParseBool(value)
+	case "es":
+		cfg.SpaceEquOperators, err = strconv.ParseBool(value)
+	default:
+		return fmt.Errorf("unknown option %q", key)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid option %s=%q: %w", key, value, err)
+	}
+
+	return nil
+}
+
+// Option returns the value of the option with the given key in the form that
+// SetOption takes, or "" if there's no such option.
+func (cfg FormatConfig) Option(key string) string {
+	switch key {
+	case "ii":
+		return strconv.Itoa(cfg.InstructionIndent)
+	case "no-indent":
+		return strconv.FormatBool(cfg.NoIndent)
+	case "iu":
+		return strconv.Itoa(cfg.IndentUnit)
+	case "ci":
+		return strconv.Itoa(cfg.CommentIndent)
+	case "ao":
+		return cfg.AlignOperands.String()
+	case "ac":
+		return strconv.FormatBool(cfg.AlignCommas)
+	case "ls":
+		return cfg.LabelSeparator.String()
+	case "dw":
+		return strconv.Itoa(cfg.DividerWidth)
+	case "dc":
+		if cfg.DividerChar == 0 {
+			return ""
+		}
+		return string(cfg.DividerChar)
+	case "pc":
+		return cfg.PseudoCase.String()
+	case "ic":
+		return cfg.InstructionCase.String()
+	case "dirc":
+		return cfg.DirectiveCase.String()
+	case "target":
+		return cfg.Target.String()
+	case "bbs":
+		return strconv.Itoa(blankLineCount(cfg.BlankLinesBeforeSection))
+	case "bas":
+		return strconv.Itoa(blankLineCount(cfg.BlankLinesAfterSection))
+	case "bbb":
+		return strconv.Itoa(blankLineCount(cfg.BlankLinesBetweenBlocks))
+	case "bab":
+		return strconv.Itoa(cfg.BlankLinesAfterBanner)
+	case "pi":
+		return strconv.Itoa(cfg.PreprocessorIndent)
+	case "cti":
+		return strconv.Itoa(cfg.ContinuationIndent)
+	case "ctw":
+		return strconv.Itoa(cfg.CommentTabWidth)
+	case "fc":
+		return strconv.FormatBool(!cfg.VerbatimComments)
+	case "untab":
+		return strconv.FormatBool(cfg.UntabComments)
+	case "cn":
+		return cfg.CommentNormalize.String()
+	case "mo":
+		return strconv.Itoa(cfg.MaxOperands)
+	case "asc":
+		return strconv.FormatBool(cfg.AlignSectionComments)
+	case "at":
+		return strconv.FormatBool(cfg.AlignTimes)
+	case "rn":
+		return strconv.FormatBool(cfg.RightAlignNumbers)
+	case "cols":
+		cols := make([]string, len(cfg.FixedColumns))
+		for i, col := range cfg.FixedColumns {
+			cols[i] = strconv.Itoa(col)
+		}
+		return strings.Join(cols, ",")
+	case "keep-scattered":
+		return strconv.FormatBool(cfg.KeepScatteredComments)
+	case "width":
+		return strconv.Itoa(cfg.MaxLineWidth)
+	case "ad":
+		return strconv.FormatBool(cfg.AlignDefines)
+	case "es":
+		return strconv.FormatBool(cfg.SpaceEquOperators)
+	default:
+		return ""
+	}
+}
+
+// parseBlankLines parses a number of blank lines into the value of one of the
+// BlankLines fields of a FormatConfig, where 0 is NoBlankLines.
+func parseBlankLines(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err == nil && n == 0 {
+		n = NoBlankLines
+	}
+	return n, err
+}
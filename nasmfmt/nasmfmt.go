@@ -1,38 +1,553 @@
+// Package nasmfmt formats NASM assembly sources.
+//
+// Formatting only ever changes the whitespace around tokens, the case of
+// keywords and the layout of lines and comments. Operands in particular are
+// never reordered, added or removed, whatever the options, since their order
+// is what gives an instruction its meaning; any new option must keep it that
+// way.
 package nasmfmt
 
 import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"text/tabwriter"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/diamondburned/nasmfmt/v2/nasm"
 )
 
+// NoBlankLines is the number of blank lines to set the BlankLines fields of a
+// FormatConfig to for no blank lines at all, as their zero value stands for a
+// single one.
+const NoBlankLines = -1
+
 // FormatConfig is the configuration for the formatter.
 type FormatConfig struct {
 	// InstructionIndent is the number of spaces to indent instructions by.
 	InstructionIndent int
 	// CommentIndent is the number of spaces to indent comments by.
 	CommentIndent int
+	// NoIndent puts every line at column zero, overriding InstructionIndent,
+	// IndentUnit, PreprocessorIndent and ContinuationIndent, including data
+	// definitions without a label. LabelSeparatorHanging is taken as
+	// LabelSeparatorTab, as there's no indentation to hang under. Columns
+	// within lines and comments are still aligned, which suits short
+	// snippets, e.g. in documentation.
+	NoIndent bool
+	// CommentTabWidth, if positive, moves the comments after code to the next
+	// tab stop of this width, counting from zero, at or past the column that
+	// they would otherwise be at, so that they line up with the tab stops of
+	// editors using tabs of this width.
+	CommentTabWidth int
+	// IndentUnit, if positive, is the number of spaces in one level of
+	// indentation. Instructions are then indented by one unit and each level
+	// of preprocessor nesting adds another, in place of InstructionIndent and
+	// PreprocessorIndent, so that nested lines always land on multiples of
+	// the unit. Use nasm.TabWidth for a tab-stop grid. If 0, the absolute
+	// InstructionIndent and PreprocessorIndent are used as they are.
+	IndentUnit int
+	// AlignOperands determines which instructions have their operands aligned
+	// into a column with each other.
+	AlignOperands AlignOperands
+	// AlignCommas aligns the operand separators of consecutive instructions
+	// into columns, so that each operand lines up with the operands of the
+	// same position in the lines around it. The first operand is always
+	// aligned regardless of this option. The values of consecutive data
+	// definitions, e.g. a jump table of dq lines, are aligned the same way.
+	//
+	// When the instructions have differing numbers of operands, a column is
+	// only as wide as its widest operand that is followed by a comma; the last
+	// operand of a line is never padded.
+	AlignCommas bool
+	// LabelSeparator is what separates a label from the instruction following
+	// it on the same line.
+	LabelSeparator LabelSeparator
+	// DividerWidth, if positive, normalizes divider comments to be exactly this
+	// many columns wide, including the semicolon. A divider comment is a
+	// full-line comment made of a single punctuation character repeated at
+	// least 3 times, e.g. ";-----" or ";=====". Otherwise, divider comments
+	// are kept as written.
+	DividerWidth int
+	// DividerChar is the character that normalized divider comments are made
+	// of. If zero, each divider keeps its own character.
+	DividerChar rune
+	// PseudoCase is the case that pseudo-instruction keywords, such as db,
+	// resb, equ and times, are written in. Labels and data are left as-is.
+	PseudoCase Case
+	// InstructionCase is the case that instruction mnemonics are written in.
+	// Their operands are left as-is, registers included, so that names
+	// aliasing registers through %define, such as "tmp" in "%define tmp rbx",
+	// are never mistaken for the registers themselves.
+	InstructionCase Case
+	// DirectiveCase is the case that directive keywords, such as global,
+	// extern and group, are written in. Their arguments are left as-is.
+	DirectiveCase Case
+	// Target is the output format that the assembly is written for. Section
+	// directives are written with the keyword that it conventionally uses.
+	Target Target
+	// BlankLinesBeforeSection is the number of blank lines before a section
+	// directive, unless it's at the start of the file. If 0, it's 1; use
+	// NoBlankLines for none.
+	BlankLinesBeforeSection int
+	// BlankLinesAfterSection is the number of blank lines after a section
+	// directive. If a section directive directly follows another, the larger
+	// of the two counts is used. If 0, it's 1; use NoBlankLines for none.
+	BlankLinesAfterSection int
+	// BlankLinesBetweenBlocks is the number of blank lines between two blocks
+	// of lines that aren't section directives, however many there were in
+	// the source. If 0, it's 1. If NoBlankLines, the blocks are joined and
+	// aligned as one, as they would be once formatted again.
+	BlankLinesBetweenBlocks int
+	// BlankLinesAfterBanner, if positive, is the number of blank lines after
+	// the banner at the start of the file, such as a license header. The
+	// banner is the first block if it's made of nothing but comments. If 0,
+	// the banner is followed by as many blank lines as any other block.
+	BlankLinesAfterBanner int
+	// PreprocessorIndent is the number of spaces to further indent lines by
+	// for each level of preprocessor nesting, such as %if and %macro blocks.
+	// The directives opening and closing a level, as well as the %elif and
+	// %else branches in between, stay at the level outside of it. If 0,
+	// nesting doesn't affect indentation.
+	PreprocessorIndent int
+	// ContinuationIndent is the number of spaces to indent the lines that
+	// continue a statement by, i.e. the lines after one ending with a
+	// backslash. If 0, they keep their original indentation.
+	ContinuationIndent int
+	// VerbatimComments keeps each comment exactly as written at its original
+	// column, while the code before it is still formatted. Otherwise, comments
+	// are aligned and the space after their semicolon is normalized.
+	VerbatimComments bool
+	// UntabComments replaces each tab within formatted comments with a space.
+	// Tabs within operands are always replaced, outside of quotes.
+	UntabComments bool
+	// MaxLineWidth, if positive, moves the comment after code onto a line of
+	// its own right before the code if it would otherwise end past this
+	// column, indented the same as the code. Comments on a line of their own
+	// that are indented the same as the code right after them are then kept
+	// there, rather than being moved to column zero, so the moved comments
+	// stay where they are when formatted again.
+	MaxLineWidth int
+	// KeepScatteredComments only aligns the comments after code in a block to
+	// CommentIndent if most of them were already at about the same column.
+	// Otherwise, each is put a single space after its code, so that a single
+	// stray comment isn't pulled far away from it.
+	KeepScatteredComments bool
+	// CommentNormalize determines whether formatted comments are written with
+	// a space after their semicolon. It has no effect with VerbatimComments.
+	CommentNormalize CommentNormalize
+	// FixedColumns, if not empty, lists the columns that instruction operands
+	// are aligned to in ascending order, like tab stops. Each line's operands
+	// start at the first column past its mnemonic, regardless of the lines
+	// around it, so that editing one line never realigns its neighbors. With
+	// AlignCommas, every following operand also starts at the next column
+	// past the previous one. Operands past the last column are separated by a
+	// single space. So are comments after code that runs past CommentIndent.
+	FixedColumns []int
+	// PostProcess, if not nil, is called on every non-empty line after parsing
+	// and before formatting. The line it returns is formatted in place of the
+	// original one, which lets callers rewrite tokens, e.g. to rename a label
+	// or inject a comment. Returning an empty line drops the line from the
+	// output entirely.
+	PostProcess func(nasm.Line) nasm.Line
+	// AlignTimes aligns the times keyword, the count, the repeated
+	// pseudo-instruction and its value into separate columns across
+	// consecutive times lines, e.g. for padding blocks in boot sectors.
+	AlignTimes bool
+	// RightAlignNumbers right-aligns the values of consecutive data
+	// definitions (db, dw, dd and so on) that only define numbers, which makes
+	// tables of constants of differing widths easier to read. Data definitions
+	// with strings or expressions are left-aligned as usual.
+	RightAlignNumbers bool
+	// AlignDefines aligns runs of consecutive constants defined with %define
+	// and its variants, together with any equ constants among them, into a
+	// table of name and value columns. The equ names are indented to the
+	// column of the %define names, e.g. "MAX equ 16" after "%define BUF_SIZE
+	// 4096" is indented to put MAX under BUF_SIZE, and "equ 16" under 4096.
+	// Function-like %defines such as "%define f(x) x" aren't constants, and
+	// runs of equ constants alone are formatted as usual.
+	AlignDefines bool
+	// SpaceEquOperators puts a single space around the binary operators in
+	// the values of equ definitions and none after their unary ones, e.g.
+	// "FOO equ 1 << 4" for "FOO equ 1<<4". Strings and character constants
+	// within the values are kept as they are.
+	SpaceEquOperators bool
+	// AlignSectionComments aligns the comments after every section directive
+	// in the file into a single column, just past the longest of them, rather
+	// than putting each a space after its own directive.
+	AlignSectionComments bool
+	// MaxOperands, if positive, wraps the operands of instructions with more
+	// operands than this onto continuation lines ending with a backslash,
+	// with at most this many operands on each line. The wrapped operands are
+	// aligned under the first one, or indented by ContinuationIndent if it's
+	// set. Instructions whose operands are kept as
+	// written or aligned to FixedColumns are never wrapped.
+	MaxOperands int
+	// AlignGroup, if not nil, returns the name of the alignment group that a
+	// line belongs to. Consecutive lines are only aligned into columns with
+	// each other if they're in the same group, so a change of group starts
+	// the columns over, e.g. to align the lines of a custom macro separately
+	// from the instructions around them. Lines that aren't of any particular
+	// group should return "". Blank lines always start the columns over.
+	//
+	// For example, this aligns the at lines of istruc blocks with each other
+	// but not with the instructions around them:
+	//
+	//	cfg.AlignGroup = func(line nasm.Line) string {
+	//		instr, ok := line.Token.(nasm.InstructionToken)
+	//		if ok && strings.EqualFold(instr.Instr, "at") {
+	//			return "at"
+	//		}
+	//		return ""
+	//	}
+	AlignGroup func(nasm.Line) string
+	// Compact separates every column with a single space instead of aligning
+	// them, puts comments a single space after the code and drops the blank
+	// lines between blocks. See CanonicalConfig.
+	Compact bool
+	// StripComments drops all comments. Lines with nothing but a comment are
+	// dropped entirely.
+	StripComments bool
+
+	// sectionCommentColumn is the column that the comments of section
+	// directives are aligned to if AlignSectionComments is true. It's
+	// computed for the whole file before any of it is written.
+	sectionCommentColumn int
+}
+
+// AlignOperands determines which instructions have their operands aligned with
+// each other.
+type AlignOperands uint8
+
+const (
+	// AlignOperandsBlock aligns the operands of all consecutive instructions
+	// within a block.
+	AlignOperandsBlock AlignOperands = iota
+	// AlignOperandsSameMnemonic only aligns the operands of consecutive
+	// instructions that share the same mnemonic, e.g. a run of movs, which
+	// keeps a long mnemonic from pushing out the operands of its neighbors.
+	AlignOperandsSameMnemonic
+)
+
+var alignOperandsNames = []string{
+	AlignOperandsBlock:        "block",
+	AlignOperandsSameMnemonic: "same-mnemonic",
+}
+
+// ParseAlignOperands parses the name of an AlignOperands mode, which is one of
+// "block" or "same-mnemonic".
+func ParseAlignOperands(name string) (AlignOperands, error) {
+	for mode, modeName := range alignOperandsNames {
+		if modeName == name {
+			return AlignOperands(mode), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown operand alignment %q", name)
+}
+
+// String returns the name of the operand alignment mode.
+func (a AlignOperands) String() string {
+	if int(a) < len(alignOperandsNames) {
+		return alignOperandsNames[a]
+	}
+	return fmt.Sprintf("AlignOperands(%d)", a)
+}
+
+// LabelSeparator determines how a label and the instruction on the same line
+// are separated.
+type LabelSeparator uint8
+
+const (
+	// LabelSeparatorTab pads the label up to the instruction indentation, the
+	// same way a tab stop would, so that the instruction lines up with the
+	// ones around it. A label too long to fit is followed by a single space.
+	LabelSeparatorTab LabelSeparator = iota
+	// LabelSeparatorSpace separates the label and the instruction with a
+	// single space.
+	LabelSeparatorSpace
+	// LabelSeparatorNewline moves the label onto its own line, leaving the
+	// instruction indented below it, e.g. "start: mov eax, 1" becomes
+	// "start:" and "mov eax, 1" on two lines. The labels of
+	// pseudo-instructions stay on their line, as equ can't do without its
+	// label.
+	LabelSeparatorNewline
+	// LabelSeparatorHanging pads the label up to the instruction indentation
+	// like LabelSeparatorTab, except that a label too long to fit pushes the
+	// instructions of its whole block further in, so that they all hang
+	// under the first instruction after the label.
+	LabelSeparatorHanging
+)
+
+var labelSeparatorNames = []string{
+	LabelSeparatorTab:     "tab",
+	LabelSeparatorSpace:   "space",
+	LabelSeparatorNewline: "newline",
+	LabelSeparatorHanging: "hanging",
+}
+
+// ParseLabelSeparator parses the name of a LabelSeparator, which is one of
+// "tab", "space", "newline" or "hanging".
+func ParseLabelSeparator(name string) (LabelSeparator, error) {
+	for sep, sepName := range labelSeparatorNames {
+		if sepName == name {
+			return LabelSeparator(sep), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown label separator %q", name)
+}
+
+// String returns the name of the label separator.
+func (s LabelSeparator) String() string {
+	if int(s) < len(labelSeparatorNames) {
+		return labelSeparatorNames[s]
+	}
+	return fmt.Sprintf("LabelSeparator(%d)", s)
+}
+
+// ParseIndentUnit parses an IndentUnit, which is either a number of spaces or
+// "tabwidth" for a unit of nasm.TabWidth spaces. Indentation is always made of
+// spaces, so there's no unit of actual tabs.
+func ParseIndentUnit(s string) (int, error) {
+	if s == "tabwidth" {
+		return nasm.TabWidth, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("negative indent unit %d", n)
+	}
+
+	return n, nil
+}
+
+// ParseColumns parses a comma-separated list of FixedColumns, e.g.
+// "16,24,40", which must be positive and in ascending order. An empty string
+// is an empty list.
+func ParseColumns(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	fields := strings.Split(s, ",")
+	columns := make([]int, len(fields))
+
+	for i, field := range fields {
+		col, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, err
+		}
+		if col <= 0 {
+			return nil, fmt.Errorf("column %d isn't positive", col)
+		}
+		if i > 0 && col <= columns[i-1] {
+			return nil, fmt.Errorf("column %d isn't past column %d", col, columns[i-1])
+		}
+		columns[i] = col
+	}
+
+	return columns, nil
+}
+
+// ErrNotText is returned when formatting what looks like a binary file.
+var ErrNotText = errors.New("not a text file")
+
+// textSniffLen is the number of bytes at the start of a source that IsText
+// looks at.
+const textSniffLen = 8000
+
+// IsText returns true if b, the start of a source, looks like text rather than
+// binary data, which is the case if it has no NUL bytes. Only the first 8000
+// bytes are looked at, the same as git does.
+func IsText(b []byte) bool {
+	if len(b) > textSniffLen {
+		b = b[:textSniffLen]
+	}
+	return bytes.IndexByte(b, 0) == -1
 }
 
 // Format formats the NASM assembly code from src and writes it to dst.
 // It formats it using the default settings.
 func Format(dst io.Writer, src io.Reader, cfg FormatConfig) error {
-	lines, err := nasm.Parse(src)
+	buf := bufio.NewReaderSize(src, textSniffLen)
+
+	prefix, err := buf.Peek(textSniffLen)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	if !IsText(prefix) {
+		return ErrNotText
+	}
+
+	lines, err := nasm.Parse(buf)
 	if err != nil {
 		return err
 	}
 
+	return FormatLines(dst, lines, cfg)
+}
+
+// FormatLines formats the already parsed lines and writes them to dst. The
+// lines are never modified, so the same lines can be formatted multiple times
+// with different configs.
+func FormatLines(dst io.Writer, lines nasm.Lines, cfg FormatConfig) error {
+	return formatLines(dst, lines, cfg, nil)
+}
+
+// RenderLine returns the line formatted the same way as it would be on its own
+// in a file, without the trailing newline. It's meant for tooling built on top
+// of the formatter, e.g. to test what a PostProcess func's lines render to. A
+// line can render to more than one line, e.g. a label moved onto its own line
+// by LabelSeparatorNewline.
+func RenderLine(line nasm.Line, cfg FormatConfig) (string, error) {
+	var b strings.Builder
+	if err := formatLines(&b, nasm.Lines{line}, cfg, nil); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// formatLines is FormatLines, but it also appends the source line of each line
+// written to sourceLines if it's not nil, or 0 for the blank lines in between
+// blocks.
+func formatLines(dst io.Writer, lines nasm.Lines, cfg FormatConfig, sourceLines *[]int) error {
+	cfg = overrideIndents(cfg)
+
+	blocks, depths := splitBlocks(lines, cfg)
+	blocks, depths = joinBlocks(blocks, depths, cfg)
+
+	if cfg.AlignSectionComments {
+		cfg.sectionCommentColumn = sectionCommentColumn(blocks, depths, cfg)
+	}
+
+	var prev nasm.Lines
+	var afterBanner bool
+
+	for i, block := range blocks {
+		// The last block is left empty if the source ends with a blank line;
+		// writing it would add yet another blank line on every run.
+		if len(block) == 0 {
+			continue
+		}
+
+		// Nothing ever goes before the first block, so the output never
+		// starts with a blank line.
+		if prev != nil {
+			n := blankLinesBetween(prev, block, cfg)
+			if cfg.BlankLinesAfterBanner > 0 && afterBanner {
+				n = cfg.BlankLinesAfterBanner
+			}
+			// Dropping the blank line that ends a continued statement would
+			// continue it onto the next block.
+			if n == 0 && endsContinued(prev) {
+				n = 1
+			}
+
+			if err := writeBlankLines(dst, n); err != nil {
+				return err
+			}
+			if sourceLines != nil && n > 0 {
+				*sourceLines = append(*sourceLines, make([]int, n)...)
+			}
+		}
+
+		if err := writeBlock(dst, block, depths[i], cfg, sourceLines); err != nil {
+			return err
+		}
+
+		afterBanner = prev == nil && isBanner(block)
+		prev = block
+	}
+
+	return nil
+}
+
+// overrideIndents returns the config with the indentation fields that
+// IndentUnit and NoIndent take the place of set accordingly.
+func overrideIndents(cfg FormatConfig) FormatConfig {
+	if cfg.IndentUnit > 0 {
+		cfg.InstructionIndent = cfg.IndentUnit
+		cfg.PreprocessorIndent = cfg.IndentUnit
+	}
+
+	if cfg.NoIndent {
+		cfg.InstructionIndent = 0
+		cfg.PreprocessorIndent = 0
+		cfg.ContinuationIndent = 0
+		if cfg.LabelSeparator == LabelSeparatorHanging {
+			cfg.LabelSeparator = LabelSeparatorTab
+		}
+	}
+
+	return cfg
+}
+
+// SplitBlocks returns the blocks that the lines are formatted in with the
+// given config, after stripping comments and post-processing if enabled. Each
+// block is aligned on its own: blocks are separated by blank lines, unless
+// there are to be none in between, and every section directive is a block by
+// itself. Comments moved onto lines of their own by MaxLineWidth are in the
+// blocks as such. It's meant for debugging why lines were or weren't aligned
+// with each other.
+func SplitBlocks(lines nasm.Lines, cfg FormatConfig) []nasm.Lines {
+	cfg = overrideIndents(cfg)
+
+	blocks, depths := splitBlocks(lines, cfg)
+	blocks, depths = joinBlocks(blocks, depths, cfg)
+
+	var nonEmpty []nasm.Lines
+	for i, block := range blocks {
+		if len(block) == 0 {
+			continue
+		}
+		if cfg.MaxLineWidth > 0 && !cfg.Compact {
+			block, _ = relocateLongComments(block, depths[i], cfg)
+		}
+		nonEmpty = append(nonEmpty, block)
+	}
+
+	return nonEmpty
+}
+
+// splitBlocks is SplitBlocks, but it also returns the preprocessor nesting
+// depth of each line in the blocks, and the last block is empty if the lines
+// end with a blank line.
+func splitBlocks(lines nasm.Lines, cfg FormatConfig) ([]nasm.Lines, [][]int) {
+	if cfg.StripComments {
+		lines = stripComments(lines)
+	}
+
+	if cfg.PostProcess != nil {
+		lines = postProcess(lines, cfg.PostProcess)
+	}
+
+	var lineDepths []int
+	if cfg.PreprocessorIndent > 0 {
+		lineDepths = preprocDepths(lines)
+	}
+
 	blocks := []nasm.Lines{nil} // slice of 1, intentionally nil!
+	depths := [][]int{nil}      // nesting depth of each line in blocks
+
 	addBlock := func() {
 		if blocks[len(blocks)-1] != nil {
 			blocks = append(blocks, nil)
+			depths = append(depths, nil)
 		}
 	}
 
+	var depth int
+
 	addToBlockN := func(line nasm.Line, n int) {
 		blocks[len(blocks)-n] = append(blocks[len(blocks)-n], line)
+		depths[len(depths)-n] = append(depths[len(depths)-n], depth)
 	}
 
 	addToBlock := func(line nasm.Line) {
@@ -42,12 +557,26 @@ func Format(dst io.Writer, src io.Reader, cfg FormatConfig) error {
 	iter := nasm.NewLineIterator(lines)
 	for iter.Next() {
 		line := iter.Current()
+		if lineDepths != nil {
+			depth = lineDepths[iter.LineNum()]
+		}
 
 		if line.IsEmpty() {
 			addBlock()
 			continue
 		}
 
+		if instr, ok := line.Token.(nasm.InstructionToken); ok && instr.Label != "" {
+			if cfg.LabelSeparator == LabelSeparatorNewline {
+				addToBlock(nasm.Line{
+					Token:      nasm.LabelToken{Label: instr.Label},
+					SourceLine: line.SourceLine,
+				})
+				instr.Label = ""
+				line.Token = instr
+			}
+		}
+
 		if _, ok := line.Token.(nasm.SectionToken); ok {
 			addBlock()
 			addToBlock(line)
@@ -58,20 +587,203 @@ func Format(dst io.Writer, src io.Reader, cfg FormatConfig) error {
 		addToBlock(line)
 	}
 
-	for _, block := range blocks {
-		if err := writeBlock(dst, block, cfg); err != nil {
-			return err
+	return blocks, depths
+}
+
+// postProcess returns the lines as rewritten by fn. The lines are cloned first,
+// so fn can't modify the caller's lines.
+func postProcess(lines nasm.Lines, fn func(nasm.Line) nasm.Line) nasm.Lines {
+	processed := make(nasm.Lines, 0, len(lines))
+
+	for _, line := range lines.Clone() {
+		if line.IsEmpty() {
+			processed = append(processed, line)
+			continue
+		}
+
+		if line = fn(line); !line.IsEmpty() {
+			processed = append(processed, line)
 		}
 	}
 
-	return nil
+	return processed
+}
+
+// joinBlocks joins each block into the one before it if there are to be no
+// blank lines in between, so that they're aligned with each other right away
+// rather than the next time the output is formatted.
+func joinBlocks(blocks []nasm.Lines, depths [][]int, cfg FormatConfig) ([]nasm.Lines, [][]int) {
+	var joinedBlocks []nasm.Lines
+	var joinedDepths [][]int
+
+	for i, block := range blocks {
+		if n := len(joinedBlocks); n > 0 && len(block) > 0 && isJoined(joinedBlocks[n-1], block, n == 1, cfg) {
+			// Copy rather than append in place, as the blocks share the
+			// backing array of the lines, which must never be modified.
+			prev := joinedBlocks[n-1]
+			joinedBlocks[n-1] = append(append(nasm.Lines(nil), prev...), block...)
+			joinedDepths[n-1] = append(append([]int(nil), joinedDepths[n-1]...), depths[i]...)
+			continue
+		}
+
+		joinedBlocks = append(joinedBlocks, block)
+		joinedDepths = append(joinedDepths, depths[i])
+	}
+
+	return joinedBlocks, joinedDepths
+}
+
+// isJoined returns true if the two consecutive blocks are written as one, first
+// being true if prev is the first block. Section directives, compacted blocks,
+// a banner followed by BlankLinesAfterBanner and a block ending with a
+// continued line, which the blank line after it ends, are always kept apart.
+func isJoined(prev, next nasm.Lines, first bool, cfg FormatConfig) bool {
+	if cfg.Compact || len(prev) == 0 || isSectionBlock(prev) || isSectionBlock(next) {
+		return false
+	}
+	if endsContinued(prev) {
+		return false
+	}
+	if cfg.BlankLinesAfterBanner > 0 && first && isBanner(prev) {
+		return false
+	}
+	return blankLineCount(cfg.BlankLinesBetweenBlocks) == 0
 }
 
-func writeBlock(dst io.Writer, block nasm.Lines, cfg FormatConfig) error {
-	lines := writeLinesNoComment(block, cfg)
+// blankLineCount returns the number of blank lines that the value of one of
+// the BlankLines fields of a FormatConfig stands for.
+func blankLineCount(n int) int {
+	switch {
+	case n == 0:
+		return 1
+	case n < 0:
+		return 0
+	default:
+		return n
+	}
+}
+
+// blankLinesBetween returns the number of blank lines to write between the
+// two consecutive blocks.
+func blankLinesBetween(prev, next nasm.Lines, cfg FormatConfig) int {
+	before := blankLineCount(cfg.BlankLinesBeforeSection)
+	after := blankLineCount(cfg.BlankLinesAfterSection)
+
+	switch prevSection, nextSection := isSectionBlock(prev), isSectionBlock(next); {
+	case prevSection && nextSection:
+		if before > after {
+			return before
+		}
+		return after
+	case nextSection:
+		return before
+	case prevSection:
+		return after
+	case cfg.Compact:
+		return 0
+	default:
+		return blankLineCount(cfg.BlankLinesBetweenBlocks)
+	}
+}
+
+// sectionCommentColumn returns the column past the longest section directive
+// with a comment in blocks, which their comments are aligned to.
+func sectionCommentColumn(blocks []nasm.Lines, depths [][]int, cfg FormatConfig) int {
+	var column int
+
+	for i, block := range blocks {
+		if !isSectionBlock(block) || block[0].Comment == (nasm.CommentToken{}) {
+			continue
+		}
+
+		s := writeLinesNoComment(block, depths[i], cfg)[0]
+		if width := utf8.RuneCountInString(s) + 1; width > column {
+			column = width
+		}
+	}
+
+	return column
+}
+
+// endsContinued returns true if the last line of the block is continued on the
+// line after it.
+func endsContinued(block nasm.Lines) bool {
+	return len(block) > 0 && block[len(block)-1].Continues()
+}
+
+// isBanner returns true if the block is made of nothing but comments.
+func isBanner(block nasm.Lines) bool {
+	for _, line := range block {
+		if line.Token != nil {
+			return false
+		}
+	}
+	return len(block) > 0
+}
+
+func isSectionBlock(block nasm.Lines) bool {
+	if len(block) != 1 {
+		return false
+	}
+	_, ok := block[0].Token.(nasm.SectionToken)
+	return ok
+}
+
+func writeBlankLines(dst io.Writer, n int) error {
+	if n <= 0 {
+		return nil
+	}
+	_, err := io.WriteString(dst, strings.Repeat("\n", n))
+	return err
+}
+
+// writeBlock writes the formatted block to dst. If sourceLines is not nil, the
+// source line of each line written is appended to it.
+func writeBlock(dst io.Writer, block nasm.Lines, depths []int, cfg FormatConfig, sourceLines *[]int) error {
+	if cfg.MaxLineWidth > 0 && !cfg.Compact {
+		block, depths = relocateLongComments(block, depths, cfg)
+	}
+
+	lines := writeLinesNoComment(block, depths, cfg)
+
+	if cfg.Compact {
+		recordSourceLines(sourceLines, block, lines)
+		return writeCompactBlock(dst, block, lines, cfg)
+	}
+
+	breaks := alignBreaks(block, cfg.AlignGroup)
+	if cfg.AlignDefines {
+		// Constants are aligned into their own table.
+		breaks = breakOnChange(breaks, constantRuns(block))
+	}
+	if cfg.AlignTimes {
+		// The columns of times lines don't match those of other lines.
+		breaks = breakOnChange(breaks, timesLines(block))
+	}
+
+	literal := make([]bool, len(block))
+	skip := make([]bool, len(block))
+	for i, line := range block {
+		literal[i] = isDiagnostic(line)
+		_, skip[i] = line.Token.(nasm.ContinuationToken)
+	}
 
 	// Vertical align the lines.
-	lines = strings.Split(valign(lines), "\n")
+	lines = strings.Split(strings.TrimSuffix(valign(lines, breaks, literal, skip), "\n"), "\n")
+
+	if cfg.MaxOperands > 0 {
+		wrapOperands(lines, block, depths, cfg)
+	}
+
+	// The tabs left in kept operands are no column separators either once
+	// the lines are aligned again.
+	for i, line := range block {
+		if instr, ok := line.Token.(nasm.InstructionToken); ok && instr.KeepOperands {
+			literal[i] = true
+		}
+	}
+
+	alignComments := !cfg.KeepScatteredComments || commentsFormColumn(block, commentColumn(cfg))
 
 	// Ugly hack to add comments after we tab-align the columns before the
 	// comments are added. We're only doing this for the sake of keeping a fixed
@@ -88,38 +800,273 @@ func writeBlock(dst io.Writer, block nasm.Lines, cfg FormatConfig) error {
 			continue
 		}
 
+		if cfg.VerbatimComments {
+			lines[i] = verbatimComment(s, line)
+			continue
+		}
+
 		if line.Token != nil {
 			switch line.Token.(type) {
-			case nasm.InstructionToken:
-				indent := cfg.CommentIndent - (len(s) + 1)
+			case nasm.InstructionToken, nasm.ContinuationToken, nasm.DirectiveToken, nasm.PseudoToken:
+				if !alignComments {
+					s += " "
+					break
+				}
+
+				width := len(lastLine(s))
+				indent := cfg.CommentIndent - (width + 1)
 				if indent < 1 {
-					indent = 1
+					// Code running past the comment column pushes the
+					// comment to the next fixed column, if any.
+					indent = len(padToColumn(width, cfg.FixedColumns))
+				}
+				if tw := cfg.CommentTabWidth; tw > 0 {
+					if col := width + indent; col%tw != 0 {
+						indent += tw - col%tw
+					}
 				}
 				s += strings.Repeat(" ", indent)
+			case nasm.SectionToken:
+				if cfg.sectionCommentColumn > 0 {
+					s += strings.Repeat(" ", cfg.sectionCommentColumn-utf8.RuneCountInString(s))
+				} else {
+					s += "\t"
+				}
 			default:
-				s += "\t"
+				if literal[i] {
+					s += " "
+				} else {
+					s += "\t"
+				}
 			}
-		} else if i > 0 && lines[i-1] != "" {
-			commentIx := strings.Index(nasm.NoQuotes(lines[i-1], "x"), ";")
-			if commentIx != -1 {
-				s += strings.Repeat(" ", commentIx)
+		} else if indent, ok := codeIndentAfter(lines, block, i); ok && cfg.MaxLineWidth > 0 {
+			s = indent
+		} else if i > 0 {
+			if indent, ok := commentColumnOf(lines[i-1]); ok {
+				s = indent
 			}
 		}
 
-		s += line.Comment.String()
+		if divider, ok := dividerComment(line, cfg); ok {
+			s += divider
+		} else if cfg.UntabComments {
+			s += strings.ReplaceAll(cfg.CommentNormalize.Apply(line.Comment), "\t", " ")
+		} else {
+			s += cfg.CommentNormalize.Apply(line.Comment)
+		}
+
 		lines[i] = s
 	}
 
+	recordSourceLines(sourceLines, block, lines)
+
+	if cfg.MaxOperands > 0 {
+		lines, breaks, literal, skip = splitWrapped(lines, breaks, literal, skip)
+	}
+
 	// Re-vertically align the lines.
-	out := valign(lines)
+	out := trimTrailingSpace(valign(lines, breaks, literal, skip))
 
 	_, err := dst.Write([]byte(out))
 	return err
 }
 
-func writeLinesNoComment(lines nasm.Lines, cfg FormatConfig) []string {
+// relocateLongComments returns the block with the comments after code that
+// make their line longer than cfg.MaxLineWidth moved onto lines of their own
+// right before the code, at the column that the code starts at, where
+// formatting again leaves them. The comments on lines continuing a statement
+// stay where they are.
+func relocateLongComments(block nasm.Lines, depths []int, cfg FormatConfig) (nasm.Lines, []int) {
+	// Number the lines to find which output lines each of them spans.
+	numbered := block.Clone()
+	for i := range numbered {
+		numbered[i].SourceLine = i + 1
+	}
+
+	var out strings.Builder
+	var outLines []int
+
+	maxWidth := cfg.MaxLineWidth
+	cfg.MaxLineWidth = 0
+
+	// Writing to a strings.Builder never fails.
+	writeBlock(&out, numbered, depths, cfg, &outLines)
+
+	// The comment is on the last output line of its line, and the code
+	// starts on the first one.
+	long := make([]bool, len(block))
+	indents := make([]int, len(block))
+	for j, s := range strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n") {
+		i := outLines[j] - 1
+		if j == 0 || outLines[j-1]-1 != i {
+			indents[i] = len(s) - len(strings.TrimLeft(s, " "))
+		}
+		long[i] = utf8.RuneCountInString(s) > maxWidth
+	}
+
+	var relocated nasm.Lines
+	var relocatedDepths []int
+
+	for i, line := range block {
+		// A comment line within a statement continued with a backslash would
+		// become part of it.
+		_, cont := line.Token.(nasm.ContinuationToken)
+
+		if long[i] && !cont && line.Token != nil && line.Comment != (nasm.CommentToken{}) {
+			comment := line.Comment
+			comment.Column = indents[i]
+			comment.Gap = 0
+
+			relocated = append(relocated, nasm.Line{
+				Comment:    comment,
+				SourceLine: line.SourceLine,
+			})
+			relocatedDepths = append(relocatedDepths, depths[i])
+			line.Comment = nasm.CommentToken{}
+		}
+		relocated = append(relocated, line)
+		relocatedDepths = append(relocatedDepths, depths[i])
+	}
+
+	return relocated, relocatedDepths
+}
+
+// codeIndentAfter returns the indentation of the code on the line after the
+// comment on a line of its own at i in the aligned lines of the block, if the
+// comment was at the same column in the source.
+func codeIndentAfter(lines []string, block nasm.Lines, i int) (string, bool) {
+	if i+1 >= len(block) || block[i+1].Token == nil {
+		return "", false
+	}
+
+	next := lines[i+1]
+	indent := next[:len(next)-len(strings.TrimLeft(next, " "))]
+	return indent, block[i].Comment.Column == len(indent)
+}
+
+// commentColumnOf returns the spaces up to the column of the comment of the
+// rendered line, which the comment on a line of its own after it is aligned
+// to, or false if it has no comment.
+func commentColumnOf(line string) (string, bool) {
+	if line == "" {
+		return "", false
+	}
+	commentIx := strings.Index(nasm.NoQuotes(lastLine(line), "x"), ";")
+	if commentIx == -1 {
+		return "", false
+	}
+	return strings.Repeat(" ", commentIx), true
+}
+
+// recordSourceLines appends the source line of each of the rendered lines of
+// the block to sourceLines, once for every output line that it spans.
+func recordSourceLines(sourceLines *[]int, block nasm.Lines, lines []string) {
+	if sourceLines == nil {
+		return
+	}
+	for i, line := range block {
+		for n := strings.Count(lines[i], "\n"); n >= 0; n-- {
+			*sourceLines = append(*sourceLines, line.SourceLine)
+		}
+	}
+}
+
+// lastLine returns the last line of s, which spans multiple lines if its
+// operands were wrapped.
+func lastLine(s string) string {
+	return s[strings.LastIndexByte(s, '\n')+1:]
+}
+
+// trimTrailingSpace trims the trailing whitespace off of every line in s. The
+// tabwriter pads cells with spaces, so a line that ends in an empty cell (e.g.
+// a lone label or an instruction without operands) would otherwise carry
+// stray spaces into the output.
+func trimTrailingSpace(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// verbatimComment appends the line's comment to s at its original column,
+// exactly as it was written. The comment is only moved if the code before it
+// has grown past its column.
+func verbatimComment(s string, line nasm.Line) string {
+	if line.Token == nil {
+		return strings.Repeat(" ", line.Comment.Column) + line.Comment.Raw
+	}
+
+	pad := line.Comment.Column - utf8.RuneCountInString(lastLine(s))
+	if pad < 1 {
+		pad = 1
+	}
+
+	return s + strings.Repeat(" ", pad) + line.Comment.Raw
+}
+
+// dividerComment returns the formatted comment if the line is a divider
+// comment. Dividers are normalized if divider normalization is enabled and kept
+// as written otherwise.
+func dividerComment(line nasm.Line, cfg FormatConfig) (string, bool) {
+	if line.Token != nil {
+		return "", false
+	}
+
+	text := strings.TrimSpace(line.Comment.Comment)
+	if utf8.RuneCountInString(text) < 3 {
+		return "", false
+	}
+
+	char, _ := utf8.DecodeRuneInString(text)
+	if !unicode.IsPunct(char) && !unicode.IsSymbol(char) {
+		return "", false
+	}
+
+	if strings.Trim(text, string(char)) != "" {
+		return "", false
+	}
+
+	if cfg.DividerWidth <= 0 {
+		return strings.TrimRight(line.Comment.Raw, " \t"), true
+	}
+
+	if cfg.DividerChar != 0 {
+		char = cfg.DividerChar
+	}
+
+	return ";" + strings.Repeat(string(char), cfg.DividerWidth-1), true
+}
+
+func writeLinesNoComment(lines nasm.Lines, depths []int, cfg FormatConfig) []string {
 	strs := make([]string, len(lines))
 
+	if cfg.LabelSeparator == LabelSeparatorHanging {
+		cfg.InstructionIndent = hangingIndent(lines, cfg.InstructionIndent)
+	}
+
+	var args [][]string
+	if cfg.AlignCommas && len(cfg.FixedColumns) == 0 {
+		args = alignCommas(lines, cfg.AlignOperands, alignBreaks(lines, cfg.AlignGroup))
+	}
+
+	// Instructions with the same mnemonic are already aligned if their
+	// operands are only separated by a space, so skip the tabwriter's column.
+	operandSep := "\t"
+	if cfg.AlignOperands == AlignOperandsSameMnemonic {
+		operandSep = " "
+	}
+
+	var constants []bool
+	if cfg.AlignDefines {
+		constants = constantRuns(lines)
+	}
+
+	var values [][]string
+	if cfg.RightAlignNumbers {
+		values = rightAlignNumbers(lines)
+	}
+
 	iter := nasm.NewLineIterator(lines)
 	for iter.Next() {
 		line := iter.Current()
@@ -127,14 +1074,76 @@ func writeLinesNoComment(lines nasm.Lines, cfg FormatConfig) []string {
 			continue
 		}
 
+		if cont, ok := line.Token.(nasm.ContinuationToken); ok {
+			strs[iter.LineNum()] = continuationLine(cont, depths[iter.LineNum()], cfg)
+			continue
+		}
+
 		var s strings.Builder
+		s.WriteString(strings.Repeat(" ", depths[iter.LineNum()]*cfg.PreprocessorIndent))
 
-		if line.Token != nil {
-			_, instr := line.Token.(nasm.InstructionToken)
-			if instr {
-				s.WriteString(strings.Repeat(" ", cfg.InstructionIndent))
+		if instr, ok := line.Token.(nasm.InstructionToken); ok {
+			s.WriteString(instructionPrefix(instr.Label, cfg))
+			s.WriteString(cfg.InstructionCase.Apply(instr.Instr))
+
+			instrArgs := instr.Args
+			if args != nil && args[iter.LineNum()] != nil {
+				instrArgs = args[iter.LineNum()]
+			}
+
+			if len(cfg.FixedColumns) > 0 {
+				writeFixedOperands(&s, instr, cfg)
+			} else if instr.KeepOperands {
+				s.WriteString(operandSep)
+				s.WriteString(escapeOperandTabs(instr.RawArgs))
+			} else if len(instrArgs) > 0 {
+				s.WriteString(operandSep)
+				s.WriteString(strings.Join(instrArgs, ", "))
+			}
+		} else if pseudo, ok := line.Token.(nasm.PseudoToken); ok {
+			pseudo.Times = cfg.PseudoCase.Apply(pseudo.Times)
+			pseudo.Instr = cfg.PseudoCase.Apply(pseudo.Instr)
+
+			if cfg.SpaceEquOperators && strings.EqualFold(pseudo.Instr, "equ") {
+				pseudo.Text = spaceOperators(pseudo.Text)
+			}
+
+			if args != nil && args[iter.LineNum()] != nil {
+				pseudo.Text = strings.Join(args[iter.LineNum()], ", ")
+			}
+			if values != nil && values[iter.LineNum()] != nil {
+				pseudo.Text = strings.Join(values[iter.LineNum()], ", ")
+			}
+
+			var str string
+			if constants != nil && constants[iter.LineNum()] {
+				// Indented to put the name in the column of the names of
+				// the %defines around it.
+				str = "\t" + pseudo.Label + "\t" + pseudo.Instr + " " + pseudo.Text
+			} else if cfg.AlignTimes && pseudo.Times != "" {
+				str = strings.Join([]string{
+					pseudo.Label, pseudo.Times, pseudo.Count, pseudo.Instr, pseudo.Text,
+				}, "\t")
+			} else {
+				str = pseudo.String()
 			}
 
+			// Without a label, the empty label cell would still be aligned
+			// with the cells of the lines around it.
+			if cfg.NoIndent && pseudo.Label == "" {
+				str = strings.TrimPrefix(str, "\t")
+			}
+
+			s.WriteString(str)
+		} else if section, ok := line.Token.(nasm.SectionToken); ok {
+			section.Keyword = cfg.Target.SectionKeyword(section.Keyword)
+			s.WriteString(section.String())
+		} else if directive, ok := line.Token.(nasm.DirectiveToken); ok {
+			directive.Keyword = cfg.DirectiveCase.Apply(directive.Keyword)
+			s.WriteString(directive.String())
+		} else if define, ok := defineConstant(line); ok && constants != nil && constants[iter.LineNum()] {
+			s.WriteString("%" + define.keyword + "\t" + define.name + "\t" + define.value)
+		} else if line.Token != nil {
 			s.WriteString(line.Token.String())
 		}
 
@@ -144,16 +1153,337 @@ func writeLinesNoComment(lines nasm.Lines, cfg FormatConfig) []string {
 	return strs
 }
 
-func valign(lines []string) string {
-	var buf strings.Builder
-	tabw := tabwriter.NewWriter(&buf, 1, 0, 1, ' ', 0)
+// continuationLine returns the line continuing the statement before it,
+// indented to cfg.ContinuationIndent or kept at its original indentation.
+func continuationLine(cont nasm.ContinuationToken, depth int, cfg FormatConfig) string {
+	indent := cont.Indent
+	if cfg.NoIndent {
+		indent = 0
+	} else if cfg.ContinuationIndent > 0 {
+		indent = depth*cfg.PreprocessorIndent + cfg.ContinuationIndent
+	}
+	return strings.Repeat(" ", indent) + cont.Text
+}
+
+// writeFixedOperands writes the operands of the instruction aligned to the
+// fixed columns in cfg.
+func writeFixedOperands(s *strings.Builder, instr nasm.InstructionToken, cfg FormatConfig) {
+	args := instr.Args
+	if instr.KeepOperands {
+		args = []string{escapeOperandTabs(instr.RawArgs)}
+	}
+
+	if len(args) == 0 || (len(args) == 1 && args[0] == "") {
+		return
+	}
+
+	if !cfg.AlignCommas {
+		args = []string{strings.Join(args, ", ")}
+	}
 
+	for i, arg := range args {
+		if i > 0 {
+			s.WriteByte(',')
+		}
+		s.WriteString(padToColumn(utf8.RuneCountInString(s.String()), cfg.FixedColumns))
+		s.WriteString(arg)
+	}
+}
+
+// padToColumn returns the spaces needed to advance from the given width to the
+// first of the columns past it, or a single space if there's none.
+func padToColumn(width int, columns []int) string {
+	for _, col := range columns {
+		if col > width {
+			return strings.Repeat(" ", col-width)
+		}
+	}
+	return " "
+}
+
+// instructionPrefix returns what goes before the mnemonic of an instruction:
+// either the indentation or the label on the same line and its separator.
+func instructionPrefix(label string, cfg FormatConfig) string {
+	if label == "" {
+		return strings.Repeat(" ", cfg.InstructionIndent)
+	}
+
+	label += ":"
+
+	switch cfg.LabelSeparator {
+	case LabelSeparatorSpace:
+		return label + " "
+	default:
+		pad := cfg.InstructionIndent - utf8.RuneCountInString(label)
+		if pad < 1 {
+			pad = 1
+		}
+		return label + strings.Repeat(" ", pad)
+	}
+}
+
+// hangingIndent returns the instruction indentation that fits every label on
+// the same line as an instruction in lines, which is at least indent.
+func hangingIndent(lines nasm.Lines, indent int) int {
 	for _, line := range lines {
-		tabw.Write([]byte(line))
-		tabw.Write([]byte("\n"))
+		instr, ok := line.Token.(nasm.InstructionToken)
+		if !ok || instr.Label == "" {
+			continue
+		}
+
+		// The label, its colon and at least a space.
+		if width := utf8.RuneCountInString(instr.Label) + 2; width > indent {
+			indent = width
+		}
+	}
+	return indent
+}
+
+// alignCommas returns the operands of each instruction and data definition in
+// lines padded so that the commas of consecutive ones land on the same
+// columns. Lines without operands to align have a nil entry and break the run
+// of aligned lines, just like they break the tabwriter's columns. So does a
+// change between instructions and data definitions, and a change of mnemonic
+// if mode is AlignOperandsSameMnemonic, and every line whose entry in breaks is
+// true.
+func alignCommas(lines nasm.Lines, mode AlignOperands, breaks []bool) [][]string {
+	args := make([][]string, len(lines))
+
+	for start := 0; start < len(lines); {
+		end := start
+		var widths []int
+
+		for ; end < len(lines); end++ {
+			operands, ok := commaOperands(lines[end])
+			if !ok || end > start && (!sameCommaRun(lines[start], lines[end], mode) ||
+				end < len(breaks) && breaks[end]) {
+				break
+			}
+
+			// The last operand is never followed by a comma, so it doesn't
+			// contribute to any column's width.
+			for i, arg := range operands[:len(operands)-1] {
+				if i == len(widths) {
+					widths = append(widths, 0)
+				}
+				if w := utf8.RuneCountInString(arg); w > widths[i] {
+					widths[i] = w
+				}
+			}
+
+			args[end] = operands
+		}
+
+		for i := start; i < end; i++ {
+			padded := make([]string, len(args[i]))
+			copy(padded, args[i])
+
+			for j := range padded[:len(padded)-1] {
+				padded[j] += strings.Repeat(" ", widths[j]-utf8.RuneCountInString(padded[j]))
+			}
+
+			args[i] = padded
+		}
+
+		if end == start {
+			end++
+		}
+		start = end
+	}
+
+	return args
+}
+
+// commaOperands returns the operands of the line that alignCommas aligns, and
+// false if there are none: the operands of an instruction, or the values of a
+// data definition without a times prefix, e.g. the entries of a dq table.
+func commaOperands(line nasm.Line) ([]string, bool) {
+	switch token := line.Token.(type) {
+	case nasm.InstructionToken:
+		return token.Args, len(token.Args) > 0 && !token.KeepOperands
+	case nasm.PseudoToken:
+		if token.Times != "" || !isDataDefinition(line) {
+			return nil, false
+		}
+		values := nasm.SplitOperands(token.Text)
+		return values, len(values) > 0
+	default:
+		return nil, false
+	}
+}
+
+// sameCommaRun returns true if the commas of the line are aligned with those of
+// the first line of the run.
+func sameCommaRun(first, line nasm.Line, mode AlignOperands) bool {
+	firstInstr, ok := first.Token.(nasm.InstructionToken)
+	instr, isInstr := line.Token.(nasm.InstructionToken)
+	if ok != isInstr {
+		return false
+	}
+	return !ok || mode != AlignOperandsSameMnemonic || strings.EqualFold(instr.Instr, firstInstr.Instr)
+}
+
+// escapeLiteralTabs escapes the tabs within quotes in the line, e.g. in the
+// string of a db, as well as the ones within its comment, so that the
+// tabwriter keeps them as they are rather than take them as column separators.
+// Tabs that are already escaped, such as those of kept operands, are left as
+// they are.
+func escapeLiteralTabs(line string) string {
+	if !strings.ContainsRune(line, '\t') {
+		return line
+	}
+
+	noq := []rune(nasm.NoQuotes(line, "x"))
+	var comment bool
+
+	var b strings.Builder
+	var i int
+	for start, r := range line {
+		_, size := utf8.DecodeRuneInString(line[start:])
+		if noq[i] == ';' {
+			comment = true
+		}
+		if r == '\t' && (comment || noq[i] != '\t') {
+			b.WriteByte(tabwriter.Escape)
+			b.WriteRune(r)
+			b.WriteByte(tabwriter.Escape)
+		} else {
+			// Copy the bytes rather than the rune, so that escape bytes,
+			// which aren't valid UTF-8, are kept.
+			b.WriteString(line[start : start+size])
+		}
+		i++
+	}
+
+	return b.String()
+}
+
+// escapeOperandTabs escapes the tabs outside of quotes in operands that are
+// kept as written, which escapeLiteralTabs would otherwise take as column
+// separators.
+func escapeOperandTabs(args string) string {
+	noq := nasm.NoQuotes(args, "x")
+	if !strings.ContainsRune(noq, '\t') {
+		return args
+	}
+
+	noqr := []rune(noq)
+
+	var b strings.Builder
+	var i int
+	for _, r := range args {
+		if r == '\t' && noqr[i] == '\t' {
+			b.WriteByte(tabwriter.Escape)
+			b.WriteRune(r)
+			b.WriteByte(tabwriter.Escape)
+		} else {
+			b.WriteRune(r)
+		}
+		i++
+	}
+
+	return b.String()
+}
+
+// escapeTabs escapes every tab in the line.
+func escapeTabs(line string) string {
+	esc := string([]byte{tabwriter.Escape, '\t', tabwriter.Escape})
+	return strings.ReplaceAll(line, "\t", esc)
+}
+
+// alignBreaks returns, for each line in the block, whether it starts a new
+// alignment group according to fn. It returns nil if fn is nil.
+func alignBreaks(block nasm.Lines, fn func(nasm.Line) string) []bool {
+	if fn == nil {
+		return nil
+	}
+
+	breaks := make([]bool, len(block))
+	var prev string
+
+	for i, line := range block {
+		group := fn(line)
+		breaks[i] = i > 0 && group != prev
+		prev = group
+	}
+
+	return breaks
+}
+
+// timesLines returns whether each line is a pseudo-instruction with a times
+// prefix.
+func timesLines(block nasm.Lines) []bool {
+	times := make([]bool, len(block))
+	for i, line := range block {
+		pseudo, ok := line.Token.(nasm.PseudoToken)
+		times[i] = ok && pseudo.Times != ""
+	}
+	return times
+}
+
+// breakOnChange returns breaks with the columns also started over at every
+// line whose entry in kinds differs from the line before it. breaks may be nil.
+func breakOnChange(breaks, kinds []bool) []bool {
+	changed := make([]bool, len(kinds))
+	for i := range kinds {
+		changed[i] = i < len(breaks) && breaks[i] || i > 0 && kinds[i] != kinds[i-1]
+	}
+	return changed
+}
+
+// valign aligns the tab-separated cells of the lines into columns. The columns
+// are started over at every line whose entry in breaks is true. The lines whose
+// entry in literal is true have all of their tabs kept as they are, so they
+// have no cells at all. The lines whose entry in skip is true, such as the
+// lines continuing a statement, are aligned on their own, so that the lines
+// around them are aligned with each other as if they weren't there.
+func valign(lines []string, breaks, literal, skip []bool) string {
+	var aligned, skipped strings.Builder
+	tabw := tabwriter.NewWriter(&aligned, 1, 0, 1, ' ', tabwriter.StripEscape)
+	skipw := tabwriter.NewWriter(&skipped, 1, 0, 1, ' ', tabwriter.StripEscape)
+
+	for i, line := range lines {
+		w := tabw
+		if i < len(skip) && skip[i] {
+			w = skipw
+		} else if i < len(breaks) && breaks[i] {
+			tabw.Flush()
+		}
+
+		// Only consecutive skipped lines are aligned with each other.
+		if i > 0 && i < len(skip) && skip[i] && !skip[i-1] {
+			skipw.Flush()
+		}
+
+		if i < len(literal) && literal[i] {
+			line = escapeTabs(line)
+		} else {
+			line = escapeLiteralTabs(line)
+		}
+		w.Write([]byte(line))
+		w.Write([]byte("\n"))
 	}
 
 	tabw.Flush()
+	skipw.Flush()
+
+	if skipped.Len() == 0 {
+		return aligned.String()
+	}
+
+	alignedLines := strings.SplitAfter(aligned.String(), "\n")
+	skippedLines := strings.SplitAfter(skipped.String(), "\n")
+
+	var buf strings.Builder
+	for i := range lines {
+		if i < len(skip) && skip[i] {
+			buf.WriteString(skippedLines[0])
+			skippedLines = skippedLines[1:]
+		} else {
+			buf.WriteString(alignedLines[0])
+			alignedLines = alignedLines[1:]
+		}
+	}
 
 	return buf.String()
 }
@@ -0,0 +1,1096 @@
+package nasmfmt
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// testConfig is the config that the command line uses by default.
+var testConfig = FormatConfig{
+	InstructionIndent: 8,
+	CommentIndent:     40,
+}
+
+func format(t *testing.T, src string, cfg FormatConfig) string {
+	t.Helper()
+
+	var b strings.Builder
+	if err := Format(&b, strings.NewReader(src), cfg); err != nil {
+		t.Fatal("cannot format:", err)
+	}
+	return b.String()
+}
+
+// assertStable asserts that formatting src with cfg gives output that doesn't
+// change when formatted again, and returns it.
+func assertStable(t *testing.T, src string, cfg FormatConfig) string {
+	t.Helper()
+
+	once := format(t, src, cfg)
+	if twice := format(t, once, cfg); twice != once {
+		t.Errorf("formatting again changes the output:\n--- once\n%s\n--- twice\n%s", once, twice)
+	}
+	return once
+}
+
+func readBench(t testing.TB) map[string]string {
+	t.Helper()
+
+	paths, err := filepath.Glob("../testdata/bench/*.asm")
+	if err != nil || len(paths) == 0 {
+		t.Fatal("cannot find benchmark inputs:", err)
+	}
+
+	srcs := make(map[string]string, len(paths))
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		srcs[filepath.Base(path)] = string(b)
+	}
+	return srcs
+}
+
+func TestBlankLinesBetweenBlocks(t *testing.T) {
+	const src = "" +
+		"\tmov eax, 1\n" +
+		"\n\n\n" +
+		"\tmovzx ebx, byte [rsp]\n"
+
+	tests := []struct {
+		name string
+		n    int
+		want string
+	}{
+		{"zero", 0, "" +
+			"        mov eax, 1\n" +
+			"\n" +
+			"        movzx ebx, byte [rsp]\n"},
+		{"two", 2, "" +
+			"        mov eax, 1\n" +
+			"\n\n" +
+			"        movzx ebx, byte [rsp]\n"},
+		{"none", NoBlankLines, "" +
+			"        mov   eax, 1\n" +
+			"        movzx ebx, byte [rsp]\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			cfg.BlankLinesBetweenBlocks = test.n
+
+			if got := assertStable(t, src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestNoBlankLinesStable(t *testing.T) {
+	cfg := testConfig
+	cfg.BlankLinesBetweenBlocks = NoBlankLines
+
+	for name, src := range readBench(t) {
+		t.Run(name, func(t *testing.T) {
+			assertStable(t, src, cfg)
+		})
+	}
+}
+
+func TestNoBlankLinesContinuation(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "block",
+			src:  "%define EMPTY \\\n\n\tmov eax, 1\n\n\tnop\n",
+			want: "%define EMPTY \\\n\n        mov eax, 1\n        nop\n",
+		},
+		{
+			name: "section",
+			src:  "%define EMPTY \\\n\nsection .text\n",
+			want: "%define EMPTY \\\n\nsection .text\n",
+		},
+	}
+
+	cfg := testConfig
+	cfg.BlankLinesBetweenBlocks = NoBlankLines
+	cfg.BlankLinesBeforeSection = NoBlankLines
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := assertStable(t, test.src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestZeroConfigFormatsComments(t *testing.T) {
+	const src = "mov eax,1;one\n"
+	const want = "mov eax, 1 ; one\n"
+
+	if got := assertStable(t, src, FormatConfig{}); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestVerbatimComments(t *testing.T) {
+	const src = "\tmov eax,1      ;one\n"
+	const want = "        mov eax, 1     ;one\n"
+
+	cfg := testConfig
+	cfg.VerbatimComments = true
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestBlankLinesAroundSection(t *testing.T) {
+	const src = "" +
+		"global _start\n" +
+		"section .text\n" +
+		"_start:\n"
+
+	tests := []struct {
+		name          string
+		before, after int
+		want          string
+	}{
+		{"zero", 0, 0, "" +
+			"global _start\n" +
+			"\n" +
+			"section .text\n" +
+			"\n" +
+			"_start:\n"},
+		{"none", NoBlankLines, NoBlankLines, "" +
+			"global _start\n" +
+			"section .text\n" +
+			"_start:\n"},
+		{"before", 2, NoBlankLines, "" +
+			"global _start\n" +
+			"\n\n" +
+			"section .text\n" +
+			"_start:\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			cfg.BlankLinesBeforeSection = test.before
+			cfg.BlankLinesAfterSection = test.after
+
+			if got := assertStable(t, src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestAlignTimes(t *testing.T) {
+	const src = "" +
+		"buf:\ttimes 64 db 0\n" +
+		"buffer:\ttimes 4 dw 1\n" +
+		"message:\tdb \"hi\", 0\n" +
+		"x:\tdw 1\n"
+
+	const want = "" +
+		"buf    times 64 db 0\n" +
+		"buffer times 4  dw 1\n" +
+		"message db \"hi\", 0\n" +
+		"x       dw 1\n"
+
+	cfg := testConfig
+	cfg.AlignTimes = true
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestTimesKeywordColumn(t *testing.T) {
+	const src = "" +
+		"a: db 1\n" +
+		"bb: dw 2\n" +
+		"times 510-($-$$) db 0\n"
+
+	// The times line doesn't widen the keyword column of the lines above.
+	const want = "" +
+		"a  db    1\n" +
+		"bb dw    2\n" +
+		"   times 510-($-$$) db 0\n"
+
+	if got := assertStable(t, src, testConfig); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestReservations(t *testing.T) {
+	const src = "" +
+		"section .bss\n" +
+		"buf: resb 4096\n" +
+		"len: resd 1\n" +
+		"long_name: resq BUF_SIZE*2\n" +
+		"x: resw 1\n" +
+		"times 4 resb 1\n" +
+		"\n" +
+		"other: resb 16\n" +
+		"section .text\n" +
+		"\tret\n"
+
+	const want = "" +
+		"section .bss\n" +
+		"\n" +
+		"buf       resb  4096\n" +
+		"len       resd  1\n" +
+		"long_name resq  BUF_SIZE*2\n" +
+		"x         resw  1\n" +
+		"          times 4 resb 1\n" +
+		"\n" +
+		"other resb 16\n" +
+		"\n" +
+		"section .text\n" +
+		"\n" +
+		"        ret\n"
+
+	if got := assertStable(t, src, testConfig); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestNoIndent(t *testing.T) {
+	const src = "" +
+		"start:\n" +
+		"\tmov eax, 1\n" +
+		"%macro m 0\n" +
+		"\tmov eax, \\\n" +
+		"\t\t1\n" +
+		"\tdb 0\n" +
+		"%endmacro\n"
+
+	const want = "" +
+		"start:\n" +
+		"mov eax, 1\n" +
+		"%macro m 0\n" +
+		"mov eax, \\\n" +
+		"1\n" +
+		"db  0\n" +
+		"%endmacro\n"
+
+	cfg := testConfig
+	cfg.NoIndent = true
+	cfg.LabelSeparator = LabelSeparatorHanging
+	cfg.PreprocessorIndent = 4
+	cfg.ContinuationIndent = 4
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+// instructions returns each instruction in the source with its operands, with
+// the case of its mnemonic and the whitespace within its operands normalized.
+func instructions(t *testing.T, src string) []string {
+	t.Helper()
+
+	lines, err := nasm.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal("cannot parse:", err)
+	}
+
+	var instrs []string
+	for _, line := range lines {
+		instr, ok := line.Token.(nasm.InstructionToken)
+		if !ok {
+			continue
+		}
+
+		s := strings.ToLower(instr.Instr)
+		for _, arg := range instr.Args {
+			s += " | " + strings.Join(strings.Fields(arg), " ")
+		}
+		instrs = append(instrs, s)
+	}
+	return instrs
+}
+
+func TestOperandsPreserved(t *testing.T) {
+	configs := map[string]func(*FormatConfig){
+		"default":      func(*FormatConfig) {},
+		"align commas": func(cfg *FormatConfig) { cfg.AlignCommas = true },
+		"same mnemonic": func(cfg *FormatConfig) {
+			cfg.AlignOperands = AlignOperandsSameMnemonic
+		},
+		"fixed columns": func(cfg *FormatConfig) { cfg.FixedColumns = []int{16, 24, 40} },
+		"no indent":     func(cfg *FormatConfig) { cfg.NoIndent = true },
+		"newline labels": func(cfg *FormatConfig) {
+			cfg.LabelSeparator = LabelSeparatorNewline
+		},
+		"hanging labels": func(cfg *FormatConfig) {
+			cfg.LabelSeparator = LabelSeparatorHanging
+		},
+		"upper case": func(cfg *FormatConfig) {
+			cfg.InstructionCase = CaseUpper
+			cfg.PseudoCase = CaseUpper
+		},
+		"canonical": func(cfg *FormatConfig) { *cfg = CanonicalConfig() },
+	}
+
+	for name, src := range readBench(t) {
+		want := instructions(t, src)
+
+		for cfgName, setup := range configs {
+			t.Run(name+"/"+cfgName, func(t *testing.T) {
+				cfg := testConfig
+				setup(&cfg)
+
+				got := instructions(t, format(t, src, cfg))
+				if len(got) != len(want) {
+					t.Fatalf("got %d instructions, want %d", len(got), len(want))
+				}
+				for i := range got {
+					if got[i] != want[i] {
+						t.Fatalf("instruction %d is %q, want %q", i, got[i], want[i])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestParseIndentUnit(t *testing.T) {
+	tests := []struct {
+		s    string
+		unit int
+		ok   bool
+	}{
+		{"4", 4, true},
+		{"0", 0, true},
+		{"tabwidth", nasm.TabWidth, true},
+		{"tab", 0, false},
+		{"-1", 0, false},
+	}
+
+	for _, test := range tests {
+		unit, err := ParseIndentUnit(test.s)
+		if (err == nil) != test.ok || unit != test.unit {
+			t.Errorf("ParseIndentUnit(%q) = %d, %v; want %d, ok: %v", test.s, unit, err, test.unit, test.ok)
+		}
+	}
+}
+
+func TestKeepOperandsTabs(t *testing.T) {
+	const src = "" +
+		"\tmov eax,\t[ebx]  ; nasmfmt:keep-operands\n" +
+		"\tmovzx ecx, byte [rsi]\n"
+
+	const want = "" +
+		"        mov   eax,\t[ebx]               ; nasmfmt:keep-operands\n" +
+		"        movzx ecx, byte [rsi]\n"
+
+	if got := assertStable(t, src, testConfig); got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestRenderLine(t *testing.T) {
+	line := nasm.Line{
+		Token: nasm.InstructionToken{
+			Label: "start",
+			Instr: "mov",
+			Args:  []string{"eax", "1"},
+		},
+		Comment: nasm.CommentToken{Comment: "one"},
+	}
+
+	cfg := testConfig
+	cfg.LabelSeparator = LabelSeparatorNewline
+
+	const want = "start:\n        mov eax, 1                     ; one"
+
+	got, err := RenderLine(line, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestLabelTrailingSpace(t *testing.T) {
+	tests := []struct {
+		src, want string
+	}{
+		{"loop:   \n", "loop:\n"},
+		{"loop:\t \n\tjmp loop\n", "loop:\n        jmp loop\n"},
+	}
+
+	for _, test := range tests {
+		if got := assertStable(t, test.src, testConfig); got != test.want {
+			t.Errorf("Format(%q) = %q, want %q", test.src, got, test.want)
+		}
+	}
+}
+
+func TestAlignCommas(t *testing.T) {
+	const src = "" +
+		"\tmov al, 1\n" +
+		"\tmov rbx, 0x10\n" +
+		"\timul ecx, [rsp+8], 3\n" +
+		"\tadd r8d, 2\n" +
+		"\tpush rax\n"
+
+	// Only operands followed by a comma are padded, so lines with fewer
+	// operands never get trailing padding.
+	const want = "" +
+		"        mov  al , 1\n" +
+		"        mov  rbx, 0x10\n" +
+		"        imul ecx, [rsp+8], 3\n" +
+		"        add  r8d, 2\n" +
+		"        push rax\n"
+
+	cfg := testConfig
+	cfg.AlignCommas = true
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestAlignCommasData(t *testing.T) {
+	const src = "" +
+		"table:\tdq .case0,.c1, .case_two\n" +
+		"\tdq .case10, .c11,.c\n" +
+		"\tdq .x\n" +
+		"\tdb \"a,b\", 0\n" +
+		"\ttimes 2 dq 0, 0\n"
+
+	// Strings keep their commas, and times lines aren't aligned, as the
+	// count comes before their values.
+	const want = "" +
+		"table dq    .case0 , .c1 , .case_two\n" +
+		"      dq    .case10, .c11, .c\n" +
+		"      dq    .x\n" +
+		"      db    \"a,b\"  , 0\n" +
+		"      times 2 dq 0, 0\n"
+
+	cfg := testConfig
+	cfg.AlignCommas = true
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestLabelSeparator(t *testing.T) {
+	const src = "" +
+		"start: mov eax, 1\n" +
+		"\tret\n"
+
+	tests := []struct {
+		sep  LabelSeparator
+		want string
+	}{
+		{LabelSeparatorTab, "" +
+			"start:  mov eax, 1\n" +
+			"        ret\n"},
+		{LabelSeparatorSpace, "" +
+			"start: mov  eax, 1\n" +
+			"        ret\n"},
+		{LabelSeparatorNewline, "" +
+			"start:\n" +
+			"        mov eax, 1\n" +
+			"        ret\n"},
+		{LabelSeparatorHanging, "" +
+			"start:  mov eax, 1\n" +
+			"        ret\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.sep.String(), func(t *testing.T) {
+			cfg := testConfig
+			cfg.LabelSeparator = test.sep
+
+			if got := assertStable(t, src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLabelSeparatorHangingLongLabel(t *testing.T) {
+	const src = "" +
+		"averylonglabel: mov eax, 1\n" +
+		"\tret\n"
+
+	const want = "" +
+		"averylonglabel: mov eax, 1\n" +
+		"                ret\n"
+
+	cfg := testConfig
+	cfg.LabelSeparator = LabelSeparatorHanging
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPseudoCase(t *testing.T) {
+	const src = "" +
+		"x:\tDB 1\n" +
+		"y:\tDw \"Ab\"\n" +
+		"BUF:\tRESQ 4\n"
+
+	tests := []struct {
+		c    Case
+		want string
+	}{
+		{CaseLower, "" +
+			"x   db   1\n" +
+			"y   dw   \"Ab\"\n" +
+			"BUF resq 4\n"},
+		{CaseUpper, "" +
+			"x   DB   1\n" +
+			"y   DW   \"Ab\"\n" +
+			"BUF RESQ 4\n"},
+		{CaseKeep, "" +
+			"x   DB   1\n" +
+			"y   Dw   \"Ab\"\n" +
+			"BUF RESQ 4\n"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.c.String(), func(t *testing.T) {
+			cfg := testConfig
+			cfg.PseudoCase = test.c
+
+			if got := assertStable(t, src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatLinesImmutable(t *testing.T) {
+	src := readBench(t)["large.asm"]
+
+	lines, err := nasm.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := lines.Clone()
+
+	formatLines := func(cfg FormatConfig) string {
+		var b strings.Builder
+		if err := FormatLines(&b, lines, cfg); err != nil {
+			t.Fatal(err)
+		}
+		return b.String()
+	}
+
+	upper := testConfig
+	upper.InstructionCase = CaseUpper
+	upper.PseudoCase = CaseUpper
+	upper.AlignCommas = true
+	upper.RightAlignNumbers = true
+	upper.LabelSeparator = LabelSeparatorNewline
+	upper.PostProcess = func(line nasm.Line) nasm.Line {
+		if instr, ok := line.Token.(nasm.InstructionToken); ok && len(instr.Args) > 0 {
+			// Only the copy passed in may be changed.
+			instr.Args[0] = "changed"
+		}
+		return line
+	}
+
+	first := formatLines(testConfig)
+	formatLines(upper)
+
+	if !reflect.DeepEqual(lines, orig) {
+		t.Error("formatting changed the lines")
+	}
+	if again := formatLines(testConfig); again != first {
+		t.Error("formatting the same lines again gives different output")
+	}
+}
+
+func TestPostProcess(t *testing.T) {
+	const src = "" +
+		"old:\n" +
+		"\tjmp old ; loop\n" +
+		"\tint3\n"
+
+	const want = "" +
+		"renamed:\n" +
+		"        jmp old                        ; loop\n"
+
+	cfg := testConfig
+	cfg.PostProcess = func(line nasm.Line) nasm.Line {
+		switch token := line.Token.(type) {
+		case nasm.LabelToken:
+			if token.Label == "old" {
+				token.Label = "renamed"
+				line.Token = token
+			}
+		case nasm.InstructionToken:
+			if token.Instr == "int3" {
+				return nasm.Line{}
+			}
+		}
+		return line
+	}
+
+	if got := format(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFixedColumns(t *testing.T) {
+	cfg := testConfig
+	cfg.FixedColumns = []int{16, 24, 40}
+
+	const before = "" +
+		"\tmov eax, 1\n" +
+		"\tpush rbx\n"
+
+	const after = "" +
+		"\tmov eax, 1\n" +
+		"\tvpbroadcastq ymm0, xmm1\n" +
+		"\tpush rbx\n"
+
+	const want = "" +
+		"        mov     eax, 1\n" +
+		"        vpbroadcastq    ymm0, xmm1\n" +
+		"        push    rbx\n"
+
+	got := assertStable(t, after, cfg)
+	if got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+
+	// The lines around the long one are the same as without it.
+	gotLines := strings.Split(got, "\n")
+	beforeLines := strings.Split(assertStable(t, before, cfg), "\n")
+	if gotLines[0] != beforeLines[0] || gotLines[2] != beforeLines[1] {
+		t.Errorf("adding a long line moved its neighbors:\n%s\nwithout it:\n%s", got, strings.Join(beforeLines, "\n"))
+	}
+}
+
+func TestUnterminatedQuotes(t *testing.T) {
+	const src = "" +
+		"x:\tdb \"hello ; not a comment\n" +
+		"y:\tdb 'a, b\n" +
+		"z:\tdb `c\\`d\n"
+
+	const want = "" +
+		"x db \"hello ; not a comment\n" +
+		"y db 'a, b\n" +
+		"z db `c\\`d\n"
+
+	if got := assertStable(t, src, testConfig); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestNotText(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		text bool
+	}{
+		{"source", "\tmov eax, 1\n", true},
+		{"empty", "", true},
+		{"binary", "\x7fELF\x02\x01\x01\x00\x00", false},
+		{"late NUL", strings.Repeat("\tnop\n", textSniffLen/5) + "\x00", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsText([]byte(test.src)); got != test.text {
+				t.Errorf("IsText = %v, want %v", got, test.text)
+			}
+
+			var out strings.Builder
+			err := Format(&out, strings.NewReader(test.src), testConfig)
+			if test.text && err != nil {
+				t.Errorf("Format failed: %v", err)
+			}
+			if !test.text {
+				if !errors.Is(err, ErrNotText) {
+					t.Errorf("Format returned %v, want %v", err, ErrNotText)
+				}
+				if out.Len() > 0 {
+					t.Errorf("Format wrote %q", out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	tests := []struct {
+		s       string
+		columns []int
+		ok      bool
+	}{
+		{"16,24,40", []int{16, 24, 40}, true},
+		{" 16, 24 ", []int{16, 24}, true},
+		{"", nil, true},
+		{"16,x", nil, false},
+		{"24,16", nil, false},
+		{"16,16", nil, false},
+		{"0,16", nil, false},
+	}
+
+	for _, test := range tests {
+		columns, err := ParseColumns(test.s)
+		if (err == nil) != test.ok || !reflect.DeepEqual(columns, test.columns) {
+			t.Errorf("ParseColumns(%q) = %v, %v; want %v, ok: %v", test.s, columns, err, test.columns, test.ok)
+		}
+	}
+}
+
+func TestFixedColumnsSnap(t *testing.T) {
+	cfg := testConfig
+	cfg.FixedColumns = []int{16, 24, 40}
+
+	tests := []struct {
+		src  string
+		want string
+	}{
+		{"\tmov eax, 1\n", "        mov     eax, 1\n"},
+		{"\tvmovdqa eax, 1\n", "        vmovdqa eax, 1\n"},
+		{"\tvmovdqa64 zmm0, [rsi]\n", "        vmovdqa64       zmm0, [rsi]\n"},
+		{"\tvpbroadcastmw2d zmm0, k1\n", "        vpbroadcastmw2d zmm0, k1\n"},
+		{"\tlong_macro_name_here zmm0, k1\n", "        long_macro_name_here            zmm0, k1\n"},
+	}
+
+	for _, test := range tests {
+		if got := assertStable(t, test.src, cfg); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
+
+func TestSplitBlocks(t *testing.T) {
+	const src = "" +
+		"\tmov eax, 1 ; a rather long comment that goes on and on\n" +
+		"\n" +
+		"\tmovzx ebx, byte [rsp]\n" +
+		"section .data\n" +
+		"x:\tdb 1\n"
+
+	tests := []struct {
+		name string
+		cfg  func(*FormatConfig)
+		want [][]int // the source line of each line in each block
+	}{
+		{"default", func(*FormatConfig) {}, [][]int{{1}, {3}, {4}, {5}}},
+		{"no blank lines", func(cfg *FormatConfig) { cfg.BlankLinesBetweenBlocks = NoBlankLines }, [][]int{{1, 3}, {4}, {5}}},
+		{"max line width", func(cfg *FormatConfig) { cfg.MaxLineWidth = 60 }, [][]int{{1, 1}, {3}, {4}, {5}}},
+	}
+
+	lines, err := nasm.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			test.cfg(&cfg)
+
+			var got [][]int
+			for _, block := range SplitBlocks(lines, cfg) {
+				var sourceLines []int
+				for _, line := range block {
+					sourceLines = append(sourceLines, line.SourceLine)
+				}
+				got = append(got, sourceLines)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got blocks %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestFormatCases(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  func(*FormatConfig)
+		src  string
+		want string
+	}{
+		{
+			name: "import and export arguments",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"import  funcname   dllname\n" +
+				"export funcname  funcname=alias ; alias\n",
+			want: "" +
+				"import funcname dllname\n" +
+				"export funcname funcname=alias         ; alias\n",
+		},
+		{
+			name: "group arguments",
+			cfg:  func(cfg *FormatConfig) { cfg.DirectiveCase = CaseLower },
+			src: "" +
+				"group  dgroup   _data  _bss\n" +
+				"GROUP dgroup _data\n",
+			want: "" +
+				"group dgroup _data _bss\n" +
+				"group dgroup _data\n",
+		},
+		{
+			name: "target elf",
+			cfg:  func(cfg *FormatConfig) { cfg.Target = TargetELF },
+			src: "" +
+				"segment .text\n" +
+				"SEGMENT .data\n" +
+				"section .bss\n",
+			want: "" +
+				"section .text\n" +
+				"\n" +
+				"SECTION .data\n" +
+				"\n" +
+				"section .bss\n",
+		},
+		{
+			name: "target obj",
+			cfg:  func(cfg *FormatConfig) { cfg.Target = TargetOBJ },
+			src: "" +
+				"section .text\n" +
+				"SECTION .data\n" +
+				"segment .bss\n",
+			want: "" +
+				"segment .text\n" +
+				"\n" +
+				"SEGMENT .data\n" +
+				"\n" +
+				"segment .bss\n",
+		},
+		{
+			name: "target auto",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"segment .text\n" +
+				"section .data\n",
+			want: "" +
+				"segment .text\n" +
+				"\n" +
+				"section .data\n",
+		},
+		{
+			name: "same mnemonic operands",
+			cfg:  func(cfg *FormatConfig) { cfg.AlignOperands = AlignOperandsSameMnemonic },
+			src: "" +
+				"\tmov eax, 1\n" +
+				"\tcmovne esi, edi\n" +
+				"\tadd ecx, 3\n" +
+				"\tadd rdx, 4\n" +
+				"\tcmovne esi, edi\n" +
+				"\tmov ebx, 2\n",
+			want: "" +
+				"        mov eax, 1\n" +
+				"        cmovne esi, edi\n" +
+				"        add ecx, 3\n" +
+				"        add rdx, 4\n" +
+				"        cmovne esi, edi\n" +
+				"        mov ebx, 2\n",
+		},
+		{
+			name: "block operands",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"\tmov eax, 1\n" +
+				"\tcmovne esi, edi\n" +
+				"\tadd ecx, 3\n",
+			want: "" +
+				"        mov    eax, 1\n" +
+				"        cmovne esi, edi\n" +
+				"        add    ecx, 3\n",
+		},
+		{
+			name: "align group",
+			cfg: func(cfg *FormatConfig) {
+				cfg.AlignCommas = true
+				cfg.AlignGroup = func(line nasm.Line) string {
+					instr, ok := line.Token.(nasm.InstructionToken)
+					if ok && strings.EqualFold(instr.Instr, "at") {
+						return "at"
+					}
+					return ""
+				}
+			},
+			src: "" +
+				"\tmovzx eax, byte [rsi]\n" +
+				"\tat point.x, dd 1\n" +
+				"\tat point.label, db 'a'\n" +
+				"\tmov ebx, 2\n",
+			want: "" +
+				"        movzx eax, byte [rsi]\n" +
+				"        at point.x    , dd 1\n" +
+				"        at point.label, db 'a'\n" +
+				"        mov ebx, 2\n",
+		},
+		{
+			name: "banner",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"; Copyright\n" +
+				"\n\n\n" +
+				"bits 64\n",
+			want: "" +
+				"; Copyright\n" +
+				"\n" +
+				"        bits 64\n",
+		},
+		{
+			name: "blank lines after banner",
+			cfg:  func(cfg *FormatConfig) { cfg.BlankLinesAfterBanner = 2 },
+			src: "" +
+				"; Copyright\n" +
+				"\n" +
+				"bits 64\n",
+			want: "" +
+				"; Copyright\n" +
+				"\n\n" +
+				"        bits 64\n",
+		},
+		{
+			name: "banner without blank lines between blocks",
+			cfg: func(cfg *FormatConfig) {
+				cfg.BlankLinesBetweenBlocks = NoBlankLines
+				cfg.BlankLinesAfterBanner = 1
+			},
+			src: "" +
+				"; Copyright\n" +
+				"\n" +
+				"bits 64\n" +
+				"\n" +
+				"\tnop\n",
+			want: "" +
+				"; Copyright\n" +
+				"\n" +
+				"        bits 64\n" +
+				"        nop\n",
+		},
+		{
+			name: "section comments",
+			cfg:  func(cfg *FormatConfig) { cfg.AlignSectionComments = true },
+			src: "" +
+				"section .text ; code\n" +
+				"\tret\n" +
+				"section .data ; initialized data\n" +
+				"msg db 1\n" +
+				"section .rodata ; constants\n",
+			want: "" +
+				"section .text   ; code\n" +
+				"\n" +
+				"        ret\n" +
+				"\n" +
+				"section .data   ; initialized data\n" +
+				"\n" +
+				"msg db 1\n" +
+				"\n" +
+				"section .rodata ; constants\n",
+		},
+		{
+			name: "directive and pseudo comments",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"global main ; export\n" +
+				"msg db \"hi\" ; greeting\n" +
+				"\tmov eax, 1 ; one\n",
+			want: "" +
+				"global main                            ; export\n" +
+				"msg         db \"hi\"                    ; greeting\n" +
+				"        mov eax, 1                     ; one\n",
+		},
+		{
+			name: "anonymous macro labels",
+			cfg:  func(cfg *FormatConfig) { cfg.PreprocessorIndent = 4 },
+			src: "" +
+				"%macro m 0\n" +
+				"..@4321.next:\n" +
+				"\tmov eax, 1\n" +
+				"..@4321.done: jmp ..@4321.next\n" +
+				"%endmacro\n" +
+				"func:\n" +
+				"..@12.x:\tnop\n" +
+				".local:\n" +
+				"\tret\n",
+			want: "" +
+				"%macro m 0\n" +
+				"    ..@4321.next:\n" +
+				"            mov       eax, 1\n" +
+				"    ..@4321.done: jmp ..@4321.next\n" +
+				"%endmacro\n" +
+				"func:\n" +
+				"..@12.x: nop\n" +
+				".local:\n" +
+				"        ret\n",
+		},
+		{
+			name: "escaped identifiers",
+			cfg:  func(cfg *FormatConfig) { cfg.SpaceEquOperators = true },
+			src: "" +
+				"$eax:\tmov eax, $ebx\n" +
+				"len equ $-$eax\n",
+			want: "" +
+				"$eax:   mov eax, $ebx\n" +
+				"len         equ $ - $eax\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			test.cfg(&cfg)
+
+			if got := assertStable(t, test.src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLocaleIndependent(t *testing.T) {
+	const src = "" +
+		"table: dd 1000, 0x1f, 2.5\n" +
+		"\tdd 1234567, 1e10, -3\n" +
+		"\tmovzx edi, byte [rsi + 15]\n" +
+		"\tinc edi\n"
+
+	cfg := testConfig
+	cfg.RightAlignNumbers = true
+	cfg.InstructionCase = CaseUpper
+	cfg.PseudoCase = CaseUpper
+
+	t.Setenv("LC_ALL", "C")
+	want := assertStable(t, src, cfg)
+
+	for _, locale := range []string{"tr_TR.UTF-8", "de_DE.UTF-8", "ar_SA.UTF-8"} {
+		t.Run(locale, func(t *testing.T) {
+			t.Setenv("LC_ALL", locale)
+			t.Setenv("LC_NUMERIC", locale)
+			t.Setenv("LC_CTYPE", locale)
+
+			if got := assertStable(t, src, cfg); got != want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package nasmfmt
+
+import (
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// numberRe matches a single NASM numeric constant, be it decimal, hexadecimal,
+// octal, binary or floating-point, optionally signed.
+var numberRe = regexp.MustCompile(`^[-+]?(?:` + strings.Join([]string{
+	`0[xXhH][0-9a-fA-F_]+`,
+	`\$[0-9][0-9a-fA-F_]*`,
+	`[0-9][0-9a-fA-F_]*[hHxX]`,
+	`0[oOqQ][0-7_]+`,
+	`[0-7][0-7_]*[oOqQ]`,
+	`0[bByY][01_]+`,
+	`[01][01_]*[bByY]`,
+	`0[dDtT][0-9_]+`,
+	`[0-9][0-9_]*[dDtT]?`,
+	`[0-9][0-9_]*\.[0-9_]*(?:[eE][-+]?[0-9]+)?`,
+}, "|") + `)$`)
+
+// dataKeywords are the pseudo-instructions that define initialized data.
+var dataKeywords = []string{"db", "dw", "dd", "dq", "dt", "ddq", "do", "dy", "dz"}
+
+func isDataDefinition(line nasm.Line) bool {
+	pseudo, ok := line.Token.(nasm.PseudoToken)
+	if !ok {
+		return false
+	}
+	for _, kw := range dataKeywords {
+		if strings.EqualFold(pseudo.Instr, kw) {
+			return true
+		}
+	}
+	return false
+}
+
+// rightAlignNumbers returns the values of each data definition in lines that
+// only defines numbers, padded so that the values of consecutive data
+// definitions are right-aligned by position. Lines that define anything else,
+// such as strings or expressions, have a nil entry and are left as they are,
+// but they don't break the run of data definitions. The numbers are only ever
+// padded, never parsed and written back, so the output can't depend on the
+// locale.
+func rightAlignNumbers(lines nasm.Lines) [][]string {
+	values := make([][]string, len(lines))
+
+	for start := 0; start < len(lines); {
+		end := start
+		var widths []int
+
+		for ; end < len(lines) && isDataDefinition(lines[end]); end++ {
+			vals := nasm.SplitOperands(lines[end].Token.(nasm.PseudoToken).Text)
+			if !allNumbers(vals) {
+				continue
+			}
+
+			for i, val := range vals {
+				if i == len(widths) {
+					widths = append(widths, 0)
+				}
+				if w := utf8.RuneCountInString(val); w > widths[i] {
+					widths[i] = w
+				}
+			}
+
+			values[end] = vals
+		}
+
+		for i := start; i < end; i++ {
+			for j, val := range values[i] {
+				values[i][j] = strings.Repeat(" ", widths[j]-utf8.RuneCountInString(val)) + val
+			}
+		}
+
+		if end == start {
+			end++
+		}
+		start = end
+	}
+
+	return values
+}
+
+func allNumbers(vals []string) bool {
+	for _, val := range vals {
+		if !numberRe.MatchString(val) {
+			return false
+		}
+	}
+	return len(vals) > 0
+}
@@ -0,0 +1,148 @@
+package nasmfmt
+
+import (
+	"bytes"
+	"io"
+	"sort"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// OffsetMap maps byte offsets in a source to byte offsets in its formatted
+// output, which editors can use to keep the cursor in place across a format.
+// The mapping is line-granular: an offset is mapped onto the output line that
+// its source line was formatted into, at the same column if that line is long
+// enough, or at its end otherwise.
+type OffsetMap struct {
+	// srcStarts is the offset of the start of each source line.
+	srcStarts []int
+	// dstStarts and dstLens are the offset and length of the output line
+	// that each source line was formatted into. Source lines that weren't
+	// written themselves, e.g. blank lines, map to the start of the output
+	// line of the next source line that was.
+	dstStarts []int
+	dstLens   []int
+	srcLen    int
+	dstLen    int
+}
+
+// FormatWithMap is like Format, but it also returns the map from byte offsets
+// in src to byte offsets in the output written to dst.
+func FormatWithMap(dst io.Writer, src io.Reader, cfg FormatConfig) (OffsetMap, error) {
+	srcBytes, err := io.ReadAll(src)
+	if err != nil {
+		return OffsetMap{}, err
+	}
+
+	if !IsText(srcBytes) {
+		return OffsetMap{}, ErrNotText
+	}
+
+	lines, err := nasm.Parse(bytes.NewReader(srcBytes))
+	if err != nil {
+		return OffsetMap{}, err
+	}
+
+	var out bytes.Buffer
+	var sourceLines []int
+
+	if err := formatLines(&out, lines, cfg, &sourceLines); err != nil {
+		return OffsetMap{}, err
+	}
+
+	m := newOffsetMap(srcBytes, out.Bytes(), sourceLines)
+
+	if _, err := dst.Write(out.Bytes()); err != nil {
+		return OffsetMap{}, err
+	}
+
+	return m, nil
+}
+
+// newOffsetMap returns the map from src to dst, where sourceLines is the
+// 1-based source line of each line in dst, or 0 if it has none.
+func newOffsetMap(src, dst []byte, sourceLines []int) OffsetMap {
+	m := OffsetMap{
+		srcStarts: lineStarts(src),
+		srcLen:    len(src),
+		dstLen:    len(dst),
+	}
+
+	m.dstStarts = make([]int, len(m.srcStarts))
+	m.dstLens = make([]int, len(m.srcStarts))
+	for i := range m.dstStarts {
+		m.dstStarts[i] = -1
+	}
+
+	dstStarts := lineStarts(dst)
+	for i, srcLine := range sourceLines {
+		if i >= len(dstStarts) {
+			break
+		}
+
+		srcIx := srcLine - 1
+		if srcIx < 0 || srcIx >= len(m.dstStarts) || m.dstStarts[srcIx] != -1 {
+			continue
+		}
+
+		m.dstStarts[srcIx] = dstStarts[i]
+		m.dstLens[srcIx] = lineLen(dst, dstStarts[i])
+	}
+
+	// Fill in the lines that weren't written from the ones after them.
+	next := len(dst)
+	for i := len(m.dstStarts) - 1; i >= 0; i-- {
+		if m.dstStarts[i] == -1 {
+			m.dstStarts[i] = next
+		} else {
+			next = m.dstStarts[i]
+		}
+	}
+
+	return m
+}
+
+// Map returns the offset in the formatted output that corresponds to the given
+// offset in the source.
+func (m OffsetMap) Map(offset int) int {
+	if offset < 0 {
+		return 0
+	}
+	if offset >= m.srcLen || len(m.srcStarts) == 0 {
+		return m.dstLen
+	}
+
+	line := sort.SearchInts(m.srcStarts, offset+1) - 1
+
+	col := offset - m.srcStarts[line]
+	if col > m.dstLens[line] {
+		col = m.dstLens[line]
+	}
+
+	return m.dstStarts[line] + col
+}
+
+// lineStarts returns the offset of the start of each line in b.
+func lineStarts(b []byte) []int {
+	if len(b) == 0 {
+		return nil
+	}
+
+	starts := []int{0}
+	for i, c := range b {
+		if c == '\n' && i+1 < len(b) {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
+
+// lineLen returns the length of the line starting at the given offset in b,
+// excluding its newline.
+func lineLen(b []byte, start int) int {
+	if end := bytes.IndexByte(b[start:], '\n'); end != -1 {
+		return end
+	}
+	return len(b) - start
+}
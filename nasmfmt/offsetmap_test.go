@@ -0,0 +1,58 @@
+package nasmfmt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatWithMap(t *testing.T) {
+	const src = "" +
+		"start:\tmov eax,1\n" +
+		"\n" +
+		"\n" +
+		"\tmov ebx,2 ; two\n"
+
+	const want = "" +
+		"start:\n" +
+		"        mov eax, 1\n" +
+		"\n" +
+		"        mov ebx, 2                     ; two\n"
+
+	cfg := testConfig
+	cfg.LabelSeparator = LabelSeparatorNewline
+
+	var out strings.Builder
+	m, err := FormatWithMap(&out, strings.NewReader(src), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.String() != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", out.String(), want)
+	}
+
+	// Offsets keep their column within the line that they're on.
+	srcLine := strings.Index(src, "\tmov ebx")
+	outLine := strings.Index(want, "        mov ebx")
+	ebx := strings.Index(src, "ebx")
+
+	tests := []struct {
+		name   string
+		offset int
+		want   int
+	}{
+		{"start", 0, 0},
+		{"reformatted line", ebx, outLine + ebx - srcLine},
+		{"past a shorter line", strings.Index(src, "1"), len("start:")},
+		{"first blank line", strings.Index(src, "\n\n") + 1, outLine},
+		{"collapsed blank line", strings.Index(src, "\n\n") + 2, outLine},
+		{"last byte", len(src) - 1, outLine + len(src) - 1 - srcLine},
+		{"end", len(src), len(want)},
+		{"negative", -1, 0},
+	}
+
+	for _, test := range tests {
+		if got := m.Map(test.offset); got != test.want {
+			t.Errorf("%s: Map(%d) = %d, want %d", test.name, test.offset, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package nasmfmt
+
+import (
+	"strings"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// nesting describes how a preprocessor directive affects the nesting depth of
+// the lines after it.
+type nesting uint8
+
+const (
+	nestNone   nesting = iota
+	nestOpen           // %if, %macro, %rep...
+	nestMiddle         // %elif, %else
+	nestClose          // %endif, %endmacro, %endrep
+)
+
+func macroNesting(token nasm.MacroToken) nesting {
+	switch kw := token.Keyword(); {
+	case strings.HasPrefix(kw, "elif"), kw == "else":
+		return nestMiddle
+	case strings.HasPrefix(kw, "if"):
+		return nestOpen
+	case kw == "macro", kw == "imacro", kw == "rmacro", kw == "irmacro", kw == "rep":
+		// Only the keyword matters, whatever parameter spec follows it.
+		return nestOpen
+	case kw == "endif", kw == "endmacro", kw == "endm", kw == "endrep":
+		return nestClose
+	default:
+		return nestNone
+	}
+}
+
+// knownDirectives are the preprocessor directives that NASM knows, except for
+// the conditional ones, which all start with "if" or "elif".
+var knownDirectives = map[string]struct{}{
+	"define": {}, "xdefine": {}, "idefine": {}, "ixdefine": {}, "undef": {},
+	"defstr": {}, "idefstr": {}, "deftok": {}, "ideftok": {},
+	"defalias": {}, "idefalias": {}, "undefalias": {}, "clear": {},
+	"assign": {}, "iassign": {}, "strcat": {}, "strlen": {}, "substr": {},
+	"macro": {}, "imacro": {}, "rmacro": {}, "irmacro": {}, "endmacro": {},
+	"endm": {}, "unmacro": {}, "unimacro": {}, "exitmacro": {}, "rotate": {},
+	"rep": {}, "endrep": {}, "exitrep": {}, "else": {}, "endif": {},
+	"include": {}, "pathsearch": {}, "depend": {}, "use": {},
+	"push": {}, "pop": {}, "repl": {}, "arg": {}, "stacksize": {}, "local": {},
+	"line": {}, "error": {}, "warning": {}, "fatal": {}, "pragma": {},
+}
+
+// isKnownDirective returns true if the lowercase keyword is that of a
+// preprocessor directive known to NASM.
+func isKnownDirective(kw string) bool {
+	if strings.HasPrefix(kw, "if") || strings.HasPrefix(kw, "elif") {
+		return true
+	}
+	_, ok := knownDirectives[kw]
+	return ok
+}
+
+// isDiagnostic returns true if the line is a %error, %warning or %fatal
+// directive. Their message is written exactly as it is, tabs included, and they
+// never take part in the alignment of the lines around them.
+func isDiagnostic(line nasm.Line) bool {
+	macro, ok := line.Token.(nasm.MacroToken)
+	if !ok {
+		return false
+	}
+	switch macro.Keyword() {
+	case "error", "warning", "fatal":
+		return true
+	default:
+		return false
+	}
+}
+
+// preprocDepths returns the preprocessor nesting depth of each line. The
+// directives opening and closing a nesting level are at the level outside of
+// it, as are the %elif and %else branches in between. Unbalanced closing
+// directives never take the depth below zero.
+func preprocDepths(lines nasm.Lines) []int {
+	depths := make([]int, len(lines))
+	depth := 0
+
+	for i, line := range lines {
+		macro, ok := line.Token.(nasm.MacroToken)
+		if !ok {
+			depths[i] = depth
+			continue
+		}
+
+		switch macroNesting(macro) {
+		case nestOpen:
+			depths[i] = depth
+			depth++
+		case nestMiddle:
+			depths[i] = depth - 1
+		case nestClose:
+			depth--
+			depths[i] = depth
+		default:
+			depths[i] = depth
+		}
+
+		if depth < 0 {
+			depth = 0
+		}
+		if depths[i] < 0 {
+			depths[i] = 0
+		}
+	}
+
+	return depths
+}
+
+// define is a %define of a constant, without parameters.
+type define struct {
+	keyword string // as written, e.g. "define" or "xdefine"
+	name    string
+	value   string
+}
+
+// defineKeywords are the preprocessor directives that define single-line
+// macros.
+var defineKeywords = map[string]struct{}{
+	"define": {}, "xdefine": {}, "idefine": {}, "ixdefine": {},
+}
+
+// defineConstant returns the constant defined by the line if it's a %define
+// with a name and a value. Function-like macros, whose name is followed by a
+// parameter list, aren't constants.
+func defineConstant(line nasm.Line) (define, bool) {
+	macro, ok := line.Token.(nasm.MacroToken)
+	if !ok {
+		return define{}, false
+	}
+
+	if _, ok := defineKeywords[macro.Keyword()]; !ok {
+		return define{}, false
+	}
+
+	fields := strings.Fields(macro.Macro)
+	if len(fields) < 3 || strings.Contains(fields[1], "(") {
+		return define{}, false
+	}
+
+	rest := strings.TrimSpace(macro.Macro[len(fields[0]):])
+	return define{
+		keyword: fields[0],
+		name:    fields[1],
+		value:   strings.TrimSpace(rest[len(fields[1]):]),
+	}, true
+}
+
+// constantRuns returns whether each line is part of a run of consecutive
+// constants, defined with either %define or equ, that has at least one
+// %define and more than one line.
+func constantRuns(lines nasm.Lines) []bool {
+	runs := make([]bool, len(lines))
+
+	for start := 0; start < len(lines); {
+		end := start
+		var defines int
+
+		for ; end < len(lines); end++ {
+			if _, ok := defineConstant(lines[end]); ok {
+				defines++
+				continue
+			}
+			if !isEquConstant(lines[end]) {
+				break
+			}
+		}
+
+		if defines > 0 && end-start > 1 {
+			for i := start; i < end; i++ {
+				runs[i] = true
+			}
+		}
+
+		if end == start {
+			end++
+		}
+		start = end
+	}
+
+	return runs
+}
+
+// isEquConstant returns true if the line defines a constant with equ, e.g.
+// "SIZE equ 16".
+func isEquConstant(line nasm.Line) bool {
+	pseudo, ok := line.Token.(nasm.PseudoToken)
+	return ok && pseudo.Label != "" && strings.EqualFold(pseudo.Instr, "equ")
+}
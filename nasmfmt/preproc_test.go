@@ -0,0 +1,281 @@
+package nasmfmt
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+func TestAlignDefines(t *testing.T) {
+	const src = "" +
+		"%define A 1\n" +
+		"%define LONGNAME 2\n" +
+		"B equ 3\n" +
+		"\tmovzx eax, byte [rsi]\n" +
+		"\tmov eax, 1\n"
+
+	const want = "" +
+		"%define A        1\n" +
+		"%define LONGNAME 2\n" +
+		"        B        equ 3\n" +
+		"    movzx eax, byte [rsi]\n" +
+		"    mov   eax, 1\n"
+
+	cfg := testConfig
+	cfg.InstructionIndent = 4
+	cfg.AlignDefines = true
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDefineComment(t *testing.T) {
+	const src = "" +
+		"%define X 1 ; two\n" +
+		"%define LONGER 22 ;three\n"
+
+	const want = "" +
+		"%define X 1       ; two\n" +
+		"%define LONGER 22 ; three\n"
+
+	if got := assertStable(t, src, testConfig); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPreprocDepths(t *testing.T) {
+	const src = "" +
+		"%macro m 1\n" +
+		"%if 1\n" +
+		"\tmov eax, %1\n" +
+		"%else\n" +
+		"\tret\n" +
+		"%endif\n" +
+		"%endmacro\n" +
+		"%unmacro m 1\n" +
+		"%undef X\n" +
+		"\tnop\n"
+
+	lines, err := nasm.Parse(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []int{0, 1, 2, 1, 2, 1, 0, 0, 0, 0}
+	if got := preprocDepths(lines); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestMacroParams(t *testing.T) {
+	const src = "" +
+		"%macro  range 1-3\n" +
+		"\tmov eax, %1\n" +
+		"%endmacro\n" +
+		"%macro greedy 1+\n" +
+		"\tmov eax, %1\n" +
+		"%endmacro\n" +
+		"%macro default 1-2 4\n" +
+		"\tmov eax, %2\n" +
+		"%endmacro\n"
+
+	const want = "" +
+		"%macro  range 1-3\n" +
+		"            mov eax, %1\n" +
+		"%endmacro\n" +
+		"%macro greedy 1+\n" +
+		"            mov eax, %1\n" +
+		"%endmacro\n" +
+		"%macro default 1-2 4\n" +
+		"            mov eax, %2\n" +
+		"%endmacro\n"
+
+	cfg := testConfig
+	cfg.PreprocessorIndent = 4
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestContextLocalLabels(t *testing.T) {
+	const src = "" +
+		"%macro loop_n 1\n" +
+		"%push loop\n" +
+		"\tmov ecx, %1\n" +
+		"%$loop:\n" +
+		"\tdec ecx\n" +
+		"\tjnz %$loop\n" +
+		"%$$outer:\n" +
+		"\tjmp %$$outer\n" +
+		"%pop\n" +
+		"%endmacro\n" +
+		"\tnop\n"
+
+	// %push and %pop don't nest like %macro does.
+	const want = "" +
+		"%macro loop_n 1\n" +
+		"    %push loop\n" +
+		"            mov ecx, %1\n" +
+		"    %$loop:\n" +
+		"            dec ecx\n" +
+		"            jnz %$loop\n" +
+		"    %$$outer:\n" +
+		"            jmp %$$outer\n" +
+		"    %pop\n" +
+		"%endmacro\n" +
+		"        nop\n"
+
+	cfg := testConfig
+	cfg.PreprocessorIndent = 4
+
+	if got := assertStable(t, src, cfg); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPreprocFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  func(*FormatConfig)
+		src  string
+		want string
+	}{
+		{
+			name: "elif chain",
+			cfg:  func(cfg *FormatConfig) { cfg.PreprocessorIndent = 4 },
+			src: "" +
+				"%if A\n" +
+				"\tmov eax, 1\n" +
+				"%elif B\n" +
+				"\tmov eax, 2\n" +
+				"%elif C\n" +
+				"%ifdef D\n" +
+				"\tmov eax, 3\n" +
+				"%else\n" +
+				"\tmov eax, 4\n" +
+				"%endif\n" +
+				"%elif E\n" +
+				"\tmov eax, 5\n" +
+				"%else\n" +
+				"\tmov eax, 6\n" +
+				"%endif\n" +
+				"\tret\n",
+			want: "" +
+				"%if A\n" +
+				"            mov eax, 1\n" +
+				"%elif B\n" +
+				"            mov eax, 2\n" +
+				"%elif C\n" +
+				"    %ifdef D\n" +
+				"                mov eax, 3\n" +
+				"    %else\n" +
+				"                mov eax, 4\n" +
+				"    %endif\n" +
+				"%elif E\n" +
+				"            mov eax, 5\n" +
+				"%else\n" +
+				"            mov eax, 6\n" +
+				"%endif\n" +
+				"        ret\n",
+		},
+		{
+			name: "register alias",
+			cfg:  func(cfg *FormatConfig) { cfg.InstructionCase = CaseUpper },
+			src: "" +
+				"%define tmp rbx\n" +
+				"\tmov tmp, 0\n" +
+				"\tmov rax, tmp\n",
+			want: "" +
+				"%define tmp rbx\n" +
+				"        MOV tmp, 0\n" +
+				"        MOV rax, tmp\n",
+		},
+		{
+			name: "string functions",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"%strlen  len mystr\n" +
+				"%substr   ch mystr 3,1\n" +
+				"%substr ch \"a, 'b'\" 3, 1\n",
+			want: "" +
+				"%strlen len mystr\n" +
+				"%substr ch mystr 3,1\n" +
+				"%substr ch \"a, 'b'\" 3, 1\n",
+		},
+		{
+			name: "unknown directives",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"%macro m 1\n" +
+				"%$localvar  eax,1\n" +
+				"%{1}  eax\n" +
+				"\tmov %$x,1\n" +
+				"%endmacro\n",
+			want: "" +
+				"%macro m 1\n" +
+				"%$localvar  eax,1\n" +
+				"%{1}  eax\n" +
+				"        mov %$x, 1\n" +
+				"%endmacro\n",
+		},
+		{
+			name: "diagnostics",
+			cfg:  func(cfg *FormatConfig) { cfg.PreprocessorIndent = 4 },
+			src: "" +
+				"\tmov eax, 1\n" +
+				"%ifndef FEATURE\n" +
+				"%error   \"unsupported,  really\"\n" +
+				"\tmovzx ebx, byte [rsi]\n" +
+				"%warning  \"slow\"\n" +
+				"%endif\n" +
+				"\tret\n",
+			want: "" +
+				"        mov eax, 1\n" +
+				"%ifndef FEATURE\n" +
+				"    %error   \"unsupported,  really\"\n" +
+				"            movzx ebx, byte [rsi]\n" +
+				"    %warning  \"slow\"\n" +
+				"%endif\n" +
+				"        ret\n",
+		},
+		{
+			name: "use",
+			cfg:  func(cfg *FormatConfig) { cfg.PreprocessorIndent = 4 },
+			src: "" +
+				"%use  altreg\n" +
+				"%use fp\n" +
+				"%USE   smartalign\n" +
+				"\tmov eax, 1\n",
+			want: "" +
+				"%use altreg\n" +
+				"%use fp\n" +
+				"%USE smartalign\n" +
+				"        mov eax, 1\n",
+		},
+		{
+			name: "pathsearch",
+			cfg:  func(*FormatConfig) {},
+			src: "" +
+				"%pathsearch   inc \"my dir/file, v2.inc\"\n" +
+				"%pathsearch x 'a  b.inc'\n",
+			want: "" +
+				"%pathsearch inc \"my dir/file, v2.inc\"\n" +
+				"%pathsearch x 'a  b.inc'\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			test.cfg(&cfg)
+
+			if got := assertStable(t, test.src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
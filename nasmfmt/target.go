@@ -0,0 +1,74 @@
+package nasmfmt
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Target is the output format that the assembly is written for. It decides
+// the conventional spelling of some keywords.
+type Target uint8
+
+const (
+	// TargetAuto doesn't assume any output format and leaves keywords as
+	// they are.
+	TargetAuto Target = iota
+	// TargetELF is for ELF (and other flat, Unix-like) outputs, which
+	// conventionally use section.
+	TargetELF
+	// TargetOBJ is for the OMF OBJ output, which conventionally uses segment.
+	TargetOBJ
+)
+
+var targetNames = []string{
+	TargetAuto: "auto",
+	TargetELF:  "elf",
+	TargetOBJ:  "obj",
+}
+
+// ParseTarget parses the name of a Target, which is one of "auto", "elf" or
+// "obj".
+func ParseTarget(name string) (Target, error) {
+	for t, targetName := range targetNames {
+		if targetName == name {
+			return Target(t), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown target %q", name)
+}
+
+// String returns the name of the target.
+func (t Target) String() string {
+	if int(t) < len(targetNames) {
+		return targetNames[t]
+	}
+	return fmt.Sprintf("Target(%d)", t)
+}
+
+// SectionKeyword returns the keyword that the target conventionally uses in
+// place of the given section or segment keyword. The keyword keeps being
+// uppercase if it was written in uppercase.
+//
+//	auto: unchanged
+//	elf:  segment -> section
+//	obj:  section -> segment
+func (t Target) SectionKeyword(keyword string) string {
+	var conventional string
+	switch t {
+	case TargetELF:
+		conventional = "section"
+	case TargetOBJ:
+		conventional = "segment"
+	default:
+		return keyword
+	}
+
+	if strings.EqualFold(keyword, conventional) {
+		return keyword
+	}
+
+	if keyword == strings.ToUpper(keyword) {
+		return strings.ToUpper(conventional)
+	}
+	return conventional
+}
@@ -0,0 +1,94 @@
+package nasmfmt
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/diamondburned/nasmfmt/v2/nasm"
+)
+
+// wrapOperands wraps the operands of the instructions in the aligned lines of
+// the block that have more than cfg.MaxOperands operands. A wrapped line spans
+// multiple lines, each but the last ending with a backslash. The lines after
+// the first are indented the same as those continuing any other statement if
+// cfg.ContinuationIndent is set, and to the first operand otherwise.
+func wrapOperands(lines []string, block nasm.Lines, depths []int, cfg FormatConfig) {
+	if len(cfg.FixedColumns) > 0 {
+		return
+	}
+
+	// The operands are rendered padded if their commas are aligned.
+	var args [][]string
+	if cfg.AlignCommas {
+		args = alignCommas(block, cfg.AlignOperands, alignBreaks(block, cfg.AlignGroup))
+	}
+
+	for i, line := range block {
+		instr, ok := line.Token.(nasm.InstructionToken)
+		if !ok || instr.KeepOperands || len(instr.Args) <= cfg.MaxOperands {
+			continue
+		}
+
+		// Already wrapped, e.g. by a previous run.
+		if instr.Args[len(instr.Args)-1] == "\\" {
+			continue
+		}
+
+		instrArgs := instr.Args
+		if args != nil && args[i] != nil {
+			instrArgs = args[i]
+		}
+
+		// The operands are the last cell of the line, so they're never
+		// padded after.
+		operands := strings.Join(instrArgs, ", ")
+		if !strings.HasSuffix(lines[i], operands) {
+			continue
+		}
+
+		prefix := strings.TrimSuffix(lines[i], operands)
+		indent := strings.Repeat(" ", utf8.RuneCountInString(prefix))
+		if cfg.ContinuationIndent > 0 {
+			indent = strings.Repeat(" ", depths[i]*cfg.PreprocessorIndent+cfg.ContinuationIndent)
+		}
+
+		var s strings.Builder
+		s.WriteString(prefix)
+
+		for start := 0; start < len(instrArgs); start += cfg.MaxOperands {
+			end := start + cfg.MaxOperands
+			if end > len(instrArgs) {
+				end = len(instrArgs)
+			}
+
+			if start > 0 {
+				s.WriteString(indent)
+			}
+			s.WriteString(strings.Join(instrArgs[start:end], ", "))
+			if end < len(instrArgs) {
+				s.WriteString(", \\\n")
+			}
+		}
+
+		lines[i] = s.String()
+	}
+}
+
+// splitWrapped splits the lines wrapped by wrapOperands into one line each,
+// along with their entries in breaks, literal and skip, so that the lines
+// continuing them are skipped when aligning the lines again.
+func splitWrapped(lines []string, breaks, literal, skip []bool) ([]string, []bool, []bool, []bool) {
+	var splitLines []string
+	var splitBreaks, splitLiteral, splitSkip []bool
+
+	for i, line := range lines {
+		for j, part := range strings.Split(line, "\n") {
+			splitLines = append(splitLines, part)
+			splitBreaks = append(splitBreaks, j == 0 && i < len(breaks) && breaks[i])
+			splitLiteral = append(splitLiteral, i < len(literal) && literal[i])
+			splitSkip = append(splitSkip, j > 0 || i < len(skip) && skip[i])
+		}
+	}
+
+	return splitLines, splitBreaks, splitLiteral, splitSkip
+}
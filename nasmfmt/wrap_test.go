@@ -0,0 +1,65 @@
+package nasmfmt
+
+import "testing"
+
+func TestMaxOperands(t *testing.T) {
+	const src = "" +
+		"\tmov eax, [ebx+ecx*4+8] ; c\n" +
+		"\tpush rax\n" +
+		"\tmov eax, 1\n" +
+		"\tdb 0\n"
+
+	tests := []struct {
+		name string
+		cfg  func(*FormatConfig)
+		want string
+	}{
+		{
+			name: "aligned",
+			cfg:  func(*FormatConfig) {},
+			want: "" +
+				"        mov  eax, \\\n" +
+				"             [ebx+ecx*4+8]             ; c\n" +
+				"        push rax\n" +
+				"        mov  eax, \\\n" +
+				"             1\n" +
+				"             db 0\n",
+		},
+		{
+			name: "continuation indent",
+			cfg:  func(cfg *FormatConfig) { cfg.ContinuationIndent = 12 },
+			want: "" +
+				"        mov  eax, \\\n" +
+				"            [ebx+ecx*4+8]              ; c\n" +
+				"        push rax\n" +
+				"        mov  eax, \\\n" +
+				"            1\n" +
+				"             db 0\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := testConfig
+			cfg.MaxOperands = 1
+			test.cfg(&cfg)
+
+			if got := assertStable(t, src, cfg); got != test.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMaxOperandsStable(t *testing.T) {
+	for _, maxOperands := range []int{1, 2} {
+		cfg := testConfig
+		cfg.MaxOperands = maxOperands
+
+		for name, src := range readBench(t) {
+			t.Run(name, func(t *testing.T) {
+				assertStable(t, src, cfg)
+			})
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// startProfiling starts writing a CPU profile to cpuProfile if it's not
+// empty. The returned func stops it, then writes a heap profile to memProfile
+// if it's not empty, so that it covers everything allocated while formatting.
+func startProfiling(cpuProfile, memProfile string) (stop func() error, err error) {
+	var cpuFile *os.File
+
+	if cpuProfile != "" {
+		cpuFile, err = os.Create(cpuProfile)
+		if err != nil {
+			return nil, fmt.Errorf("cannot create CPU profile: %w", err)
+		}
+
+		if err := pprof.StartCPUProfile(cpuFile); err != nil {
+			cpuFile.Close()
+			return nil, fmt.Errorf("cannot start CPU profile: %w", err)
+		}
+	}
+
+	stop = func() error {
+		if cpuFile != nil {
+			pprof.StopCPUProfile()
+			if err := cpuFile.Close(); err != nil {
+				return fmt.Errorf("cannot write CPU profile: %w", err)
+			}
+		}
+
+		if memProfile == "" {
+			return nil
+		}
+
+		memFile, err := os.Create(memProfile)
+		if err != nil {
+			return fmt.Errorf("cannot create memory profile: %w", err)
+		}
+
+		// Get up-to-date statistics.
+		runtime.GC()
+
+		err = pprof.WriteHeapProfile(memFile)
+		if closeErr := memFile.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("cannot write memory profile: %w", err)
+		}
+
+		return nil
+	}
+
+	return stop, nil
+}
@@ -0,0 +1,5543 @@
+; Benchmark input: a long stretch of ordinary code.
+
+bits 64
+default rel
+
+section .text
+
+global _start
+
+func_0:
+        push rbp
+        mov rbp,rsp
+	imul rbx,3682
+  test   rax ,  r8
+.l1:	jnz .l1
+  xor   r11 ,  rbx ; step 2
+.l2:	jnz .l2
+  cmp   rdx ,  r8
+	lea rdx, [rdi+224]
+	cmp r10,2428 ; step 5
+	lea r8, [r10+192]
+	lea r8, [rax+408]
+  or   rbx ,  r9 ; step 8
+  or   r9 ,  rax
+        pop rbp
+        ret
+
+func_1:
+        push rbp
+        mov rbp,rsp
+  cmp   rcx ,  r11
+	lea rdx, [r8+360]
+  mov   r8 ,  r10
+  mov   r9 ,  rdi ; step 3
+	or r8,2712
+.l4:	jnz .l4
+  sub   r10 ,  rcx
+	and rax,136
+	and qword [rbp-24], rcx ; step 7
+  and   rsi ,  r9
+	or qword [rbp-72], rdx
+	imul qword [rbp-104], rax ; step 10
+	lea r8, [r10+456] ; step 11
+	imul rdi,2446 ; step 12
+
+  add   rbx ,  rsi ; step 13
+  sub   rax ,  r10 ; step 14
+  imul   r9 ,  rcx
+	mov qword [rbp-56], rdx
+        pop rbp
+        ret
+
+func_2:
+        push rbp
+        mov rbp,rsp
+  add   r8 ,  rsi ; step 0
+	and qword [rbp-88], rcx
+  cmp   rdx ,  rsi
+	lea r9, [r10+40] ; step 3
+  xor   rsi ,  rdi
+	and rdx,854
+	sub rcx,3096 ; step 6
+  and   rdi ,  rsi
+  mov   rsi ,  rax ; step 8
+  mov   rdx ,  r11
+  xor   rcx ,  rbx
+  and   r10 ,  rsi
+  mov   rax ,  rsi
+	add rdi,1762
+	test rdi,1702
+        pop rbp
+        ret
+
+func_3:
+        push rbp
+        mov rbp,rsp
+  add   r9 ,  rbx
+	and rax,2480 ; step 1
+	imul qword [rbp-64], rdx
+	add qword [rbp-96], r11
+	lea rdi, [rbx+176] ; step 4
+  or   rsi ,  rbx
+  xor   rcx ,  r10 ; step 6
+	lea rdx, [rcx+160] ; step 7
+  add   r9 ,  r8
+	cmp qword [rbp-8], rcx
+  mov   rax ,  rdi
+	and r8,731 ; step 11
+.l11:	jnz .l11
+        pop rbp
+        ret
+
+func_4:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-128], r11
+	imul rsi,586
+	lea rdx, [rsi+304]
+  test   r11 ,  rbx
+  sub   rcx ,  rsi
+  test   r8 ,  rdi
+	lea rbx, [rsi+272]
+.l6:	jnz .l6
+  imul   rbx ,  r9 ; step 7
+  cmp   r8 ,  rcx
+  xor   rbx ,  r8
+	xor r8,1555
+
+	imul qword [rbp-80], rsi ; step 11
+  and   r9 ,  rcx
+	imul qword [rbp-32], rdx
+.l13:	jnz .l13
+  mov   r10 ,  rsi
+	lea rdi, [rsi+360]
+        pop rbp
+        ret
+
+func_5:
+        push rbp
+        mov rbp,rsp
+  test   rdi ,  rsi
+	add qword [rbp-8], r11
+	lea rdx, [r9+416]
+	sub r9,28
+  or   r11 ,  rbx
+  mov   r8 ,  rcx
+	add qword [rbp-72], rax
+  and   rcx ,  r9
+
+	lea rbx, [r8+64]
+.l8:	jnz .l8
+  sub   rbx ,  r8 ; step 9
+	xor rdi,3016
+
+  and   r10 ,  rsi
+  test   rsi ,  rdi ; step 12
+	mov qword [rbp-112], r8
+  add   rcx ,  r9
+	test r10,1131
+  cmp   rdx ,  rbx
+  cmp   rdi ,  r9
+.l17:	jnz .l17
+  mov   rdx ,  rax
+        pop rbp
+        ret
+
+func_6:
+        push rbp
+        mov rbp,rsp
+  or   rsi ,  rbx ; step 0
+  test   r8 ,  rcx ; step 1
+  cmp   rdx ,  r9
+  add   rax ,  r11
+  imul   rsi ,  rdx
+	mov rax,467
+
+  and   rax ,  r11
+	add r8,4093
+	xor qword [rbp-72], r8
+	mov qword [rbp-16], rcx
+	lea r8, [rbx+72] ; step 10
+	xor r8,747
+	add rdi,271
+	test qword [rbp-72], r10
+.l13:	jnz .l13
+
+	cmp qword [rbp-40], rsi ; step 14
+        pop rbp
+        ret
+
+func_7:
+        push rbp
+        mov rbp,rsp
+  and   rbx ,  r8
+	lea r8, [r9+456]
+	imul r10,2386
+	or qword [rbp-24], r8 ; step 3
+	or qword [rbp-72], rsi
+	lea rbx, [rcx+328]
+  and   r9 ,  r11
+	add r11,4031
+  imul   rdi ,  r11
+  imul   rdi ,  r10 ; step 9
+  sub   rbx ,  rcx
+	lea rsi, [rbx+64]
+
+  add   rdx ,  rcx
+  test   rsi ,  rdx
+  cmp   r9 ,  rdi
+	and r8,3118
+	lea r11, [r8+464] ; step 16
+  mov   r10 ,  rbx
+        pop rbp
+        ret
+
+func_8:
+        push rbp
+        mov rbp,rsp
+	lea r11, [r10+416]
+  cmp   r11 ,  rsi ; step 1
+  sub   rsi ,  rax
+  cmp   rsi ,  rax
+  test   rsi ,  rdi
+	add r9,1214 ; step 5
+  sub   r11 ,  rsi
+	cmp qword [rbp-128], r8 ; step 7
+	cmp r8,1688
+
+  and   rcx ,  r9 ; step 9
+  mov   rbx ,  r8
+  or   rax ,  rbx
+  and   rsi ,  rcx
+  add   r8 ,  rbx ; step 13
+	add r11,3165
+	and qword [rbp-128], r10
+	imul rdi,2841
+	lea r9, [rsi+96] ; step 17
+	cmp qword [rbp-112], r11
+	lea r11, [r10+416] ; step 19
+        pop rbp
+        ret
+
+func_9:
+        push rbp
+        mov rbp,rsp
+  xor   r9 ,  rdi ; step 0
+	imul r9,2670
+  xor   rdi ,  r9 ; step 2
+  xor   rbx ,  r10 ; step 3
+  imul   rdx ,  r10
+
+	mov rsi,1839 ; step 5
+  and   r11 ,  r10 ; step 6
+	sub qword [rbp-24], rax
+  and   r10 ,  rax ; step 8
+	xor qword [rbp-88], rdi
+  sub   r11 ,  rsi
+  imul   r8 ,  r10
+	sub rax,1970 ; step 12
+  and   r10 ,  rsi ; step 13
+  or   rbx ,  r10
+        pop rbp
+        ret
+
+func_10:
+        push rbp
+        mov rbp,rsp
+  mov   r11 ,  rsi ; step 0
+.l0:	jnz .l0
+  xor   r9 ,  rdi ; step 1
+  test   r8 ,  rbx ; step 2
+  imul   rax ,  r10
+	lea rbx, [r9+440]
+	cmp r8,3856
+  mov   rbx ,  rcx
+  imul   rdi ,  r9 ; step 7
+	lea rdi, [rcx+40]
+  or   rsi ,  rax
+  or   r9 ,  rdx
+.l10:	jnz .l10
+	lea rcx, [r10+496]
+  imul   rax ,  r9
+	xor qword [rbp-88], rdx
+  test   rdi ,  r9
+  cmp   r10 ,  rbx
+  cmp   r8 ,  rbx
+        pop rbp
+        ret
+
+func_11:
+        push rbp
+        mov rbp,rsp
+	lea rsi, [rcx+104]
+.l0:	jnz .l0
+  xor   r10 ,  r8
+  sub   rcx ,  rdi ; step 2
+  sub   rcx ,  r8
+	lea rdx, [r10+72] ; step 4
+  mov   r8 ,  rbx
+  xor   rax ,  r11
+  imul   rcx ,  r10
+	lea r8, [r10+400]
+	and qword [rbp-72], rcx
+	or qword [rbp-72], rcx
+	sub qword [rbp-24], rsi
+  cmp   rdx ,  rcx
+	add rcx,3320
+        pop rbp
+        ret
+
+func_12:
+        push rbp
+        mov rbp,rsp
+	lea r10, [rbx+384] ; step 0
+	cmp qword [rbp-40], rcx
+	and rdx,844
+	mov rax,1084
+  cmp   r11 ,  rdx
+	xor r8,2085
+  imul   r8 ,  r9 ; step 6
+.l6:	jnz .l6
+	lea r10, [rdx+280]
+  add   rcx ,  r11
+.l8:	jnz .l8
+	lea rdi, [rdx+40]
+        pop rbp
+        ret
+
+func_13:
+        push rbp
+        mov rbp,rsp
+  imul   r10 ,  rdx
+  imul   rbx ,  rdi
+	sub rsi,531
+  cmp   r10 ,  rsi
+	lea r9, [rax+320]
+  and   rbx ,  rdi
+	add rax,2720
+  or   r11 ,  rbx
+	lea r10, [rax+328]
+	imul qword [rbp-112], r8
+        pop rbp
+        ret
+
+func_14:
+        push rbp
+        mov rbp,rsp
+  or   rax ,  rbx ; step 0
+  cmp   r10 ,  rsi
+.l1:	jnz .l1
+	and r8,2152 ; step 2
+.l2:	jnz .l2
+  mov   r10 ,  rsi
+	lea rdi, [r11+136] ; step 4
+  mov   rax ,  rdi ; step 5
+	lea r8, [rcx+200] ; step 6
+	lea rbx, [rsi+56]
+  imul   rdi ,  rdx
+.l8:	jnz .l8
+	sub rsi,1884
+.l9:	jnz .l9
+	mov qword [rbp-80], rdx ; step 10
+	add rdx,2432
+	or rax,858
+	cmp qword [rbp-112], rdi ; step 13
+  imul   r11 ,  r10
+	test r11,2197
+	cmp qword [rbp-80], r10 ; step 16
+        pop rbp
+        ret
+
+func_15:
+        push rbp
+        mov rbp,rsp
+  or   rdx ,  r10
+	sub r8,911
+
+	lea rax, [rsi+328]
+	mov qword [rbp-24], rbx ; step 3
+	imul rdi,1499
+  or   rsi ,  r11
+  add   rdx ,  r8
+	xor qword [rbp-40], r11
+  or   rax ,  rsi ; step 8
+  cmp   r10 ,  rdi
+  imul   rsi ,  r10
+	test qword [rbp-72], r10
+
+  imul   r10 ,  r11 ; step 12
+  add   rdx ,  rax
+
+  mov   rax ,  r11
+.l14:	jnz .l14
+
+        pop rbp
+        ret
+
+func_16:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-72], rsi
+  mov   rdx ,  r10 ; step 1
+  imul   rcx ,  r10
+  add   rax ,  rdi ; step 3
+	mov rsi,731
+	add rax,949 ; step 5
+  and   rsi ,  r10 ; step 6
+.l6:	jnz .l6
+  xor   rdi ,  r11
+  cmp   r8 ,  rbx ; step 8
+	sub qword [rbp-112], rbx
+  or   rdi ,  r8
+        pop rbp
+        ret
+
+func_17:
+        push rbp
+        mov rbp,rsp
+	or rcx,1038
+	test rcx,2079 ; step 1
+  test   rsi ,  rbx
+  sub   rdi ,  rbx
+.l3:	jnz .l3
+
+  xor   rdi ,  r11
+	or rdi,3075 ; step 5
+  xor   rax ,  rdx
+  mov   rdx ,  rsi ; step 7
+.l7:	jnz .l7
+        pop rbp
+        ret
+
+func_18:
+        push rbp
+        mov rbp,rsp
+	lea rsi, [rcx+72]
+	lea r11, [r10+448]
+  or   rdx ,  r8 ; step 2
+  xor   rax ,  rcx ; step 3
+  xor   rdx ,  rbx
+  mov   rdx ,  rdi
+	test r10,559
+  and   r11 ,  rdi
+  add   rsi ,  rbx
+	or qword [rbp-72], rdi
+  mov   rsi ,  rcx ; step 10
+  xor   rsi ,  rdi
+	and rcx,774
+        pop rbp
+        ret
+
+func_19:
+        push rbp
+        mov rbp,rsp
+  cmp   r9 ,  rcx
+	xor rbx,3863
+  sub   r11 ,  r9
+	test rcx,3017
+	lea rsi, [rbx+360] ; step 4
+  mov   rdi ,  r11 ; step 5
+
+  xor   r11 ,  rax ; step 6
+	test qword [rbp-48], rdi
+	imul qword [rbp-112], rsi
+	lea r11, [r10+208] ; step 9
+
+  or   rbx ,  r8
+        pop rbp
+        ret
+
+func_20:
+        push rbp
+        mov rbp,rsp
+  test   r10 ,  r9 ; step 0
+.l0:	jnz .l0
+	lea r8, [rcx+184]
+  imul   rdx ,  r9 ; step 2
+  imul   rsi ,  rcx ; step 3
+.l3:	jnz .l3
+	lea rdx, [r9+448]
+  test   rsi ,  r8
+  cmp   r11 ,  r9
+  xor   rdx ,  r11
+	cmp rax,3068
+	cmp qword [rbp-80], r11
+  and   rcx ,  rbx
+	lea rdi, [r11+504]
+  imul   rdi ,  r10
+	lea rdx, [rcx+200]
+
+	or qword [rbp-8], rax
+        pop rbp
+        ret
+
+func_21:
+        push rbp
+        mov rbp,rsp
+  cmp   rdx ,  rsi
+  sub   rax ,  r8
+	imul rdx,2623
+	cmp rax,3341
+  sub   rdi ,  rcx
+	lea rsi, [rdx+168]
+	add r9,1096
+	or r11,1426 ; step 7
+  mov   rbx ,  rcx
+  sub   rsi ,  rdi
+.l9:	jnz .l9
+  xor   rdx ,  r11
+	and r10,867
+  cmp   rdi ,  rbx
+  test   rsi ,  rcx
+  cmp   rdi ,  r10 ; step 14
+  xor   r8 ,  r9
+.l15:	jnz .l15
+	lea rax, [r11+280]
+  or   r8 ,  rdi ; step 17
+        pop rbp
+        ret
+
+func_22:
+        push rbp
+        mov rbp,rsp
+	or qword [rbp-64], rax
+	mov rdi,202
+.l1:	jnz .l1
+
+  imul   r8 ,  rbx
+.l2:	jnz .l2
+  cmp   r11 ,  r10 ; step 3
+	or r9,3025 ; step 4
+	xor rbx,3537
+  imul   rax ,  r8
+.l6:	jnz .l6
+  and   r11 ,  r9 ; step 7
+	test r9,1423
+  imul   rcx ,  rdi
+  cmp   r10 ,  r8
+
+  sub   r9 ,  rbx ; step 11
+	lea rsi, [rcx+136]
+  imul   rdi ,  r10
+.l13:	jnz .l13
+  and   r11 ,  rbx ; step 14
+	lea rax, [r8+328]
+        pop rbp
+        ret
+
+func_23:
+        push rbp
+        mov rbp,rsp
+  add   r11 ,  r9
+  mov   r11 ,  rbx ; step 1
+	xor rcx,1780 ; step 2
+  imul   r8 ,  rsi ; step 3
+
+  imul   rsi ,  rax ; step 4
+  test   r8 ,  r11 ; step 5
+  cmp   r8 ,  rdi
+  xor   rdx ,  rax
+  and   rcx ,  r10
+	imul qword [rbp-80], r9
+  cmp   rax ,  r8
+	add qword [rbp-88], r8 ; step 11
+  sub   r9 ,  rdi
+  cmp   r10 ,  r8 ; step 13
+	cmp qword [rbp-112], rax
+        pop rbp
+        ret
+
+func_24:
+        push rbp
+        mov rbp,rsp
+  cmp   rsi ,  r10 ; step 0
+	lea rsi, [rax+96]
+  and   rcx ,  rbx
+.l2:	jnz .l2
+  sub   r10 ,  r8
+
+	mov qword [rbp-16], rbx
+.l4:	jnz .l4
+	or rax,371
+  test   r11 ,  rdi
+	lea rdx, [rdi+248]
+  and   r10 ,  rdi
+  xor   rcx ,  rdx ; step 9
+	lea r11, [rbx+216] ; step 10
+  add   rsi ,  rdx ; step 11
+	or rbx,1891 ; step 12
+  test   rax ,  rcx
+  xor   r10 ,  rsi ; step 14
+	mov rax,630
+	add rdx,1111
+        pop rbp
+        ret
+
+func_25:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-128], r10 ; step 0
+	xor rcx,1123
+	and rdx,2291
+  cmp   r10 ,  r11 ; step 3
+  cmp   rcx ,  rdx
+  or   r8 ,  rax
+  add   rax ,  rcx
+  xor   rdx ,  rsi
+
+        pop rbp
+        ret
+
+func_26:
+        push rbp
+        mov rbp,rsp
+  and   rdx ,  r10
+  test   rcx ,  rax
+  add   rax ,  r8 ; step 2
+	add r8,2768
+  cmp   r11 ,  rdx
+  imul   rdx ,  r8
+  or   rbx ,  rax
+.l6:	jnz .l6
+  add   rdx ,  r9 ; step 7
+
+	imul r10,1368
+	xor r9,2086 ; step 9
+	or qword [rbp-24], rcx ; step 10
+  test   r9 ,  rbx
+
+	mov qword [rbp-48], rax
+	mov qword [rbp-64], rdi
+	mov r9,1254
+  sub   rdi ,  rax
+
+  cmp   rdx ,  rsi
+        pop rbp
+        ret
+
+func_27:
+        push rbp
+        mov rbp,rsp
+  cmp   r8 ,  r11 ; step 0
+	add r9,3527 ; step 1
+	lea r11, [rcx+416]
+  test   r8 ,  r10 ; step 3
+	lea rdx, [rsi+72] ; step 4
+	imul rsi,3961 ; step 5
+  sub   rbx ,  r11
+
+  and   rax ,  r10
+  add   rdi ,  r11
+  test   r10 ,  rdi
+	imul rsi,432
+	imul rdi,938
+  or   r11 ,  r10
+  sub   r8 ,  r11
+.l13:	jnz .l13
+
+	or qword [rbp-104], rcx
+	or r9,448
+	test rcx,197 ; step 16
+        pop rbp
+        ret
+
+func_28:
+        push rbp
+        mov rbp,rsp
+	lea r10, [rsi+264]
+  test   rdx ,  rbx
+
+	cmp qword [rbp-8], rsi
+	lea rdi, [r8+48]
+  add   r8 ,  r11
+
+	lea r11, [r8+352] ; step 5
+  and   r10 ,  r8 ; step 6
+  imul   r11 ,  rsi ; step 7
+	imul qword [rbp-120], rcx
+  sub   r10 ,  rbx
+  mov   rsi ,  rcx
+  mov   rbx ,  r11 ; step 11
+  xor   rbx ,  r11 ; step 12
+.l12:	jnz .l12
+	mov rsi,2841 ; step 13
+	imul r11,4008
+  imul   r8 ,  r10
+  add   rax ,  r11 ; step 16
+
+	mov qword [rbp-72], r10
+  xor   rsi ,  rcx
+        pop rbp
+        ret
+
+func_29:
+        push rbp
+        mov rbp,rsp
+  test   rbx ,  r8 ; step 0
+  xor   rdi ,  rcx
+.l1:	jnz .l1
+  test   rdi ,  rcx
+	and qword [rbp-88], r10 ; step 3
+	xor qword [rbp-8], rdi
+  test   rbx ,  r10 ; step 5
+  sub   rbx ,  r9
+	and qword [rbp-72], r11
+	test r11,137
+	xor rsi,3023
+  xor   rbx ,  rax
+  or   r11 ,  rsi
+  add   r8 ,  rdx
+  add   r11 ,  r10 ; step 13
+	lea r9, [rsi+0] ; step 14
+	test r8,1351
+  and   r10 ,  rax ; step 16
+	lea rsi, [r9+336]
+
+	lea r11, [rsi+264] ; step 18
+        pop rbp
+        ret
+
+func_30:
+        push rbp
+        mov rbp,rsp
+  and   r8 ,  r9
+  cmp   rax ,  rbx
+  and   rax ,  r8
+  mov   rdi ,  rdx
+	add rax,3633 ; step 4
+  and   r11 ,  rdx
+
+  test   r8 ,  r10
+  mov   rdx ,  rdi
+  add   rdi ,  r8 ; step 8
+  test   rbx ,  r8 ; step 9
+        pop rbp
+        ret
+
+func_31:
+        push rbp
+        mov rbp,rsp
+  add   rcx ,  rsi
+  mov   r9 ,  rcx
+	lea rax, [r10+384]
+  xor   rbx ,  rcx
+  mov   rdx ,  r8
+  sub   rax ,  r8 ; step 5
+	lea r10, [rdi+256] ; step 6
+  xor   rsi ,  r11
+  imul   rsi ,  r10
+	add rdx,2638 ; step 9
+
+	xor qword [rbp-72], rsi
+  test   r8 ,  rsi
+        pop rbp
+        ret
+
+func_32:
+        push rbp
+        mov rbp,rsp
+  and   rsi ,  r11 ; step 0
+	mov rbx,4043 ; step 1
+
+  or   rax ,  r9
+  or   r11 ,  r9
+  add   rbx ,  rcx
+  add   r8 ,  rax ; step 5
+  test   r8 ,  rcx ; step 6
+.l6:	jnz .l6
+	lea r9, [rcx+488]
+	xor qword [rbp-8], rbx ; step 8
+	mov rsi,1281
+  add   rdx ,  rcx ; step 10
+        pop rbp
+        ret
+
+func_33:
+        push rbp
+        mov rbp,rsp
+	imul qword [rbp-24], r9
+	imul qword [rbp-24], r10
+  or   rbx ,  rdi ; step 2
+	lea r11, [r10+128]
+  cmp   rdi ,  rdx
+  sub   rax ,  r11
+  test   r11 ,  rdi
+  imul   rdx ,  rcx ; step 7
+	xor qword [rbp-80], r10
+.l8:	jnz .l8
+  mov   rsi ,  r11
+        pop rbp
+        ret
+
+func_34:
+        push rbp
+        mov rbp,rsp
+  xor   rbx ,  rcx
+  mov   r10 ,  r9
+
+  imul   r8 ,  r11
+.l2:	jnz .l2
+  xor   rdi ,  rax
+	test qword [rbp-104], r11
+
+	cmp qword [rbp-112], rdi ; step 5
+  xor   rdx ,  r10
+	imul qword [rbp-56], r10 ; step 7
+	mov rax,1462
+.l8:	jnz .l8
+	lea rax, [rcx+360]
+  test   rbx ,  r8 ; step 10
+  mov   rbx ,  r9
+	xor qword [rbp-16], r11 ; step 12
+.l12:	jnz .l12
+	test qword [rbp-72], rax
+        pop rbp
+        ret
+
+func_35:
+        push rbp
+        mov rbp,rsp
+	sub qword [rbp-128], rdi ; step 0
+  xor   rdx ,  r10
+.l1:	jnz .l1
+	test rcx,2027
+  sub   r8 ,  rcx
+  imul   rbx ,  rcx
+  sub   rax ,  rbx
+  sub   r8 ,  r11
+	sub qword [rbp-16], rax
+
+        pop rbp
+        ret
+
+func_36:
+        push rbp
+        mov rbp,rsp
+  mov   rdx ,  rcx
+	xor r11,1787 ; step 1
+  or   rbx ,  r11
+	lea r10, [r9+80]
+  sub   r11 ,  rbx ; step 4
+	add qword [rbp-24], r8
+  or   r11 ,  rdi
+  cmp   rdi ,  r10
+  xor   rsi ,  r8
+  imul   rax ,  rcx
+  imul   rbx ,  rcx
+	test r8,2947
+  imul   r8 ,  rcx
+  imul   rdi ,  r10 ; step 13
+
+  xor   rdx ,  r10
+        pop rbp
+        ret
+
+func_37:
+        push rbp
+        mov rbp,rsp
+  xor   r10 ,  r11
+	mov qword [rbp-96], r10
+  cmp   rdi ,  rax
+  or   rsi ,  r11
+  test   rsi ,  rbx
+.l4:	jnz .l4
+  add   rdx ,  rbx ; step 5
+	or qword [rbp-120], rsi
+	xor r9,252 ; step 7
+        pop rbp
+        ret
+
+func_38:
+        push rbp
+        mov rbp,rsp
+  xor   rsi ,  r8
+  test   rdx ,  r11 ; step 1
+	or qword [rbp-120], r11
+  sub   r8 ,  r11 ; step 3
+.l3:	jnz .l3
+  sub   rax ,  rdx
+	and rsi,3696
+  cmp   rdi ,  rdx ; step 6
+	test qword [rbp-120], r8 ; step 7
+  and   r10 ,  rdx
+  xor   r8 ,  rax
+  test   r11 ,  r8
+	mov rsi,3530 ; step 11
+	lea rax, [rcx+168]
+	mov qword [rbp-80], r11
+.l13:	jnz .l13
+	xor rsi,2350
+        pop rbp
+        ret
+
+func_39:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  r11
+  mov   rsi ,  rax
+.l1:	jnz .l1
+  test   rax ,  r10
+  mov   r8 ,  rbx ; step 3
+	test r10,68 ; step 4
+	lea rbx, [r8+296]
+.l5:	jnz .l5
+	sub rdi,2963 ; step 6
+	add rdx,1314
+.l7:	jnz .l7
+  and   r10 ,  rbx
+	lea r10, [r9+144] ; step 9
+  sub   rdx ,  rcx
+  test   r10 ,  r11
+	lea r8, [r9+128]
+
+  and   r11 ,  rcx ; step 13
+  mov   rdi ,  rdx ; step 14
+  test   rdi ,  rcx
+
+	imul r8,246
+	sub r10,3528
+	xor rcx,944
+        pop rbp
+        ret
+
+func_40:
+        push rbp
+        mov rbp,rsp
+  mov   rax ,  rcx
+	sub r9,2412
+  mov   rsi ,  rcx ; step 2
+	and qword [rbp-128], rdx
+  mov   rdx ,  rcx ; step 4
+  add   rax ,  rdx
+  add   rsi ,  rdx
+  sub   rsi ,  r10 ; step 7
+	test r8,3238
+        pop rbp
+        ret
+
+func_41:
+        push rbp
+        mov rbp,rsp
+	test r9,3909
+	lea r10, [rdx+200]
+
+  add   rdi ,  rbx ; step 2
+  test   r9 ,  r11 ; step 3
+	lea rbx, [r8+328] ; step 4
+.l4:	jnz .l4
+	xor rdx,2531
+	mov qword [rbp-32], rsi
+  imul   r8 ,  r9
+	imul rdi,1406 ; step 8
+	imul rdi,3730
+  or   rdi ,  r11 ; step 10
+  and   rax ,  r10 ; step 11
+  test   r11 ,  r10 ; step 12
+  xor   rdi ,  rdx
+	imul qword [rbp-72], rbx
+  mov   rcx ,  rsi ; step 15
+.l15:	jnz .l15
+  test   r10 ,  rax
+  add   r10 ,  r8
+  and   rsi ,  rdi ; step 18
+        pop rbp
+        ret
+
+func_42:
+        push rbp
+        mov rbp,rsp
+  add   rcx ,  rsi
+
+	lea r9, [r10+368]
+  cmp   r11 ,  rdi
+  mov   rbx ,  rdx
+  sub   rcx ,  r8 ; step 4
+  and   rsi ,  rdi
+	or r11,1087
+  and   r8 ,  r9 ; step 7
+  sub   r9 ,  rcx
+  xor   rdi ,  rbx
+	cmp qword [rbp-8], rsi
+  and   r9 ,  r8 ; step 11
+  or   rcx ,  rdx ; step 12
+  sub   r9 ,  r10
+  sub   rax ,  rdx
+	mov qword [rbp-88], rbx
+  cmp   rdi ,  r9
+	or qword [rbp-32], rsi
+
+  or   rbx ,  r8 ; step 18
+        pop rbp
+        ret
+
+func_43:
+        push rbp
+        mov rbp,rsp
+  add   r8 ,  r9
+	test qword [rbp-56], rcx
+  xor   rdx ,  rax
+  test   rdi ,  r8
+  or   rbx ,  r8
+  xor   rbx ,  rsi
+  test   r11 ,  rsi
+  sub   r8 ,  rbx
+        pop rbp
+        ret
+
+func_44:
+        push rbp
+        mov rbp,rsp
+	lea rdx, [rcx+112]
+  add   r11 ,  r10
+  test   rsi ,  r8
+  imul   r9 ,  r8
+  mov   rbx ,  rcx ; step 4
+  sub   r8 ,  r9 ; step 5
+	cmp rax,3981
+  imul   rcx ,  r11
+  xor   rax ,  rdx
+  cmp   r10 ,  rax
+  and   r11 ,  rax
+	xor r8,3832
+  add   rbx ,  rdi
+	test r8,392
+	add rax,1471
+  add   rcx ,  rsi
+  mov   rsi ,  r9
+	mov rsi,1613 ; step 17
+.l17:	jnz .l17
+  cmp   r11 ,  rsi ; step 18
+        pop rbp
+        ret
+
+func_45:
+        push rbp
+        mov rbp,rsp
+	or qword [rbp-48], rdi
+	cmp qword [rbp-8], rdi
+  mov   rax ,  r11 ; step 2
+  add   rbx ,  rcx
+	sub r9,2929
+	imul qword [rbp-120], rax
+	add qword [rbp-112], rcx ; step 6
+.l6:	jnz .l6
+
+	or r10,1303 ; step 7
+.l7:	jnz .l7
+	and qword [rbp-24], r11 ; step 8
+        pop rbp
+        ret
+
+func_46:
+        push rbp
+        mov rbp,rsp
+	or rcx,3782
+	add rdx,722
+  imul   r11 ,  rdi
+  and   rbx ,  rcx ; step 3
+  add   r8 ,  rbx
+	lea r11, [r9+120] ; step 5
+  cmp   rdx ,  rdi
+	imul qword [rbp-32], r8
+	or r11,3755 ; step 8
+	and qword [rbp-88], rcx ; step 9
+  cmp   rdx ,  r8
+  mov   r8 ,  rcx
+  add   r10 ,  rdx ; step 12
+  or   rsi ,  r10 ; step 13
+  and   rax ,  r8
+  cmp   rbx ,  r9 ; step 15
+.l15:	jnz .l15
+  add   rdx ,  rdi
+  or   rsi ,  rdx ; step 17
+	imul rax,1209
+  xor   r8 ,  rax
+        pop rbp
+        ret
+
+func_47:
+        push rbp
+        mov rbp,rsp
+  cmp   rdx ,  rdi
+	sub rdi,3424
+  sub   rsi ,  rax
+  or   r9 ,  rdi
+	xor qword [rbp-64], rdx
+  or   rax ,  rcx
+  imul   r8 ,  rsi
+.l6:	jnz .l6
+  and   rdx ,  r8
+  cmp   r8 ,  r10 ; step 8
+  add   r8 ,  rdx
+	imul r10,2647 ; step 10
+	mov r9,3857 ; step 11
+  sub   r9 ,  r8 ; step 12
+        pop rbp
+        ret
+
+func_48:
+        push rbp
+        mov rbp,rsp
+  add   r10 ,  r11
+.l0:	jnz .l0
+	lea rcx, [rax+384] ; step 1
+	add qword [rbp-56], r9
+
+	and qword [rbp-64], rbx
+  add   r11 ,  rbx ; step 4
+	lea rsi, [r10+144]
+  add   rdx ,  rsi ; step 6
+  sub   rcx ,  rdi ; step 7
+	or r8,1825 ; step 8
+	lea rbx, [r11+232]
+  xor   r10 ,  rcx
+.l10:	jnz .l10
+  imul   rdi ,  r11
+.l11:	jnz .l11
+	sub rcx,137 ; step 12
+  cmp   rdx ,  r11
+  mov   rcx ,  rax ; step 14
+.l14:	jnz .l14
+	cmp r11,1381 ; step 15
+  sub   r9 ,  rbx ; step 16
+        pop rbp
+        ret
+
+func_49:
+        push rbp
+        mov rbp,rsp
+  test   rdx ,  rax ; step 0
+  and   rdx ,  r8
+  xor   rdi ,  r9
+	or qword [rbp-40], rdx
+  cmp   r10 ,  r8 ; step 4
+	lea rdx, [r8+480]
+	and qword [rbp-48], rdi
+  xor   rdx ,  rbx ; step 7
+  test   rsi ,  rax
+	mov rsi,2758
+  and   r11 ,  r9
+	test r8,1724
+  sub   rdx ,  rdi
+        pop rbp
+        ret
+
+func_50:
+        push rbp
+        mov rbp,rsp
+  mov   r8 ,  rdi
+  cmp   r11 ,  r10
+  sub   r8 ,  rbx
+  xor   r9 ,  r11 ; step 3
+.l3:	jnz .l3
+	mov r11,4095
+  mov   rdi ,  rdx
+	lea r8, [rax+8]
+
+  mov   r8 ,  rsi ; step 7
+	lea rdx, [rsi+456]
+	xor qword [rbp-96], r8
+	add rcx,2532 ; step 10
+  or   rdi ,  r10
+        pop rbp
+        ret
+
+func_51:
+        push rbp
+        mov rbp,rsp
+  add   rdx ,  rsi
+  test   rax ,  r9
+.l1:	jnz .l1
+	and r10,3270
+  test   rdi ,  r11 ; step 3
+	or rcx,3870 ; step 4
+  mov   rax ,  r11
+	cmp rdx,1988 ; step 6
+  cmp   rsi ,  r9
+  sub   rsi ,  rdi
+  and   rdx ,  rsi
+	mov rdi,3493
+.l10:	jnz .l10
+	lea rsi, [rdi+408]
+
+  or   rcx ,  rdx ; step 12
+  and   rbx ,  r9
+        pop rbp
+        ret
+
+func_52:
+        push rbp
+        mov rbp,rsp
+  sub   rbx ,  rax
+  xor   rax ,  r10
+  test   rsi ,  rdi
+  xor   r9 ,  rdi
+  imul   rsi ,  r11 ; step 4
+  sub   rbx ,  r10
+.l5:	jnz .l5
+  or   rsi ,  rbx ; step 6
+  cmp   r11 ,  rdi
+	sub rax,626
+	sub rax,508
+  imul   rcx ,  r8 ; step 10
+  test   rdx ,  r8
+	or qword [rbp-128], rsi
+  cmp   r11 ,  r8 ; step 13
+  test   r8 ,  rdi ; step 14
+  add   r8 ,  rcx
+
+	and rsi,1045 ; step 16
+  sub   r9 ,  rdx ; step 17
+        pop rbp
+        ret
+
+func_53:
+        push rbp
+        mov rbp,rsp
+	imul rcx,1154
+  mov   rbx ,  r10
+  and   r9 ,  r10
+	or qword [rbp-16], rsi ; step 3
+  cmp   r8 ,  rcx ; step 4
+  imul   rcx ,  rbx
+.l5:	jnz .l5
+	test qword [rbp-80], rax ; step 6
+
+  or   rbx ,  rsi
+        pop rbp
+        ret
+
+func_54:
+        push rbp
+        mov rbp,rsp
+	mov rdi,4096
+	mov r11,566
+	lea r10, [rbx+16] ; step 2
+  sub   rcx ,  rax ; step 3
+  test   rbx ,  rdi
+  imul   r10 ,  rcx
+  imul   rbx ,  rdi
+	lea r9, [r10+152]
+  and   rax ,  r8
+  or   rsi ,  rbx
+	add qword [rbp-72], rcx
+	cmp qword [rbp-128], rcx
+  imul   r9 ,  rax
+	imul r11,3093
+.l13:	jnz .l13
+	mov qword [rbp-120], rax
+  xor   r9 ,  rdx
+        pop rbp
+        ret
+
+func_55:
+        push rbp
+        mov rbp,rsp
+	and qword [rbp-24], rcx
+
+  imul   r9 ,  r10 ; step 1
+  sub   rbx ,  rdi
+  test   rdx ,  rbx ; step 3
+  and   rax ,  rdi
+	lea r11, [r9+256]
+	lea rbx, [r11+120]
+
+	lea rdi, [rbx+480]
+	sub qword [rbp-104], rax
+.l8:	jnz .l8
+	lea rcx, [rbx+208] ; step 9
+  test   rdx ,  r8
+
+	mov r9,1442
+  add   r10 ,  rax ; step 12
+	and r11,2682 ; step 13
+	imul qword [rbp-120], r9
+        pop rbp
+        ret
+
+func_56:
+        push rbp
+        mov rbp,rsp
+	or rdx,1132
+  sub   rsi ,  rdi
+
+	imul qword [rbp-40], r8
+	cmp rdx,2590
+  xor   rcx ,  r8 ; step 4
+  sub   rdi ,  r9
+	and rdx,2665 ; step 6
+	and rcx,2921 ; step 7
+        pop rbp
+        ret
+
+func_57:
+        push rbp
+        mov rbp,rsp
+  mov   r8 ,  r9
+.l0:	jnz .l0
+	cmp qword [rbp-48], rdi
+  or   rbx ,  r8
+  or   rdx ,  rbx
+  mov   r11 ,  rdi
+  mov   rax ,  rcx ; step 5
+.l5:	jnz .l5
+  sub   r9 ,  rbx ; step 6
+	sub rbx,3245 ; step 7
+	add rax,1763 ; step 8
+	xor qword [rbp-88], r8
+	imul qword [rbp-112], rdx
+
+  and   r9 ,  rdx ; step 11
+  sub   rdx ,  r11
+
+	lea rdx, [r9+96] ; step 13
+	test qword [rbp-96], rdx ; step 14
+	cmp qword [rbp-56], rdx ; step 15
+	or rsi,1031
+	mov r10,3908 ; step 17
+  cmp   r9 ,  r10
+        pop rbp
+        ret
+
+func_58:
+        push rbp
+        mov rbp,rsp
+	sub rsi,3165 ; step 0
+  imul   rdx ,  r9
+  mov   rax ,  rsi
+	mov r10,2453
+  sub   rsi ,  r11
+  or   r8 ,  r10
+	imul r9,691
+  xor   r9 ,  rdi
+  mov   rdx ,  r9
+  add   rax ,  rdx
+  imul   rbx ,  rdx
+.l10:	jnz .l10
+        pop rbp
+        ret
+
+func_59:
+        push rbp
+        mov rbp,rsp
+  imul   rcx ,  rdi ; step 0
+	imul rax,1560 ; step 1
+	or r11,2484
+
+  or   rcx ,  rsi
+  cmp   r9 ,  rbx
+  and   r8 ,  rsi ; step 5
+  imul   rdx ,  r10 ; step 6
+	lea r10, [rdx+64]
+.l7:	jnz .l7
+  and   rax ,  r11 ; step 8
+	add rcx,355
+        pop rbp
+        ret
+
+func_60:
+        push rbp
+        mov rbp,rsp
+  mov   r8 ,  r9 ; step 0
+  xor   rbx ,  rdi ; step 1
+  and   rdi ,  rax
+  sub   r8 ,  rax
+
+	cmp rcx,3829 ; step 4
+  sub   r8 ,  r9
+	imul r11,3295 ; step 6
+	cmp rdi,3186
+.l7:	jnz .l7
+
+  or   rdi ,  rax
+	lea rcx, [r11+136]
+  cmp   rdx ,  r11 ; step 10
+	lea r9, [rcx+400] ; step 11
+  and   rdi ,  rbx ; step 12
+
+	lea r8, [rdi+56] ; step 13
+
+  or   r9 ,  rcx
+  add   r11 ,  rbx ; step 15
+        pop rbp
+        ret
+
+func_61:
+        push rbp
+        mov rbp,rsp
+  cmp   rdi ,  r10 ; step 0
+  imul   r10 ,  r8
+	lea rdx, [rcx+280]
+  sub   rdx ,  rsi
+.l3:	jnz .l3
+	lea rbx, [rcx+168]
+	or qword [rbp-112], r10
+	xor rcx,1849
+.l6:	jnz .l6
+	lea rax, [rcx+16]
+  sub   rdi ,  r8
+        pop rbp
+        ret
+
+func_62:
+        push rbp
+        mov rbp,rsp
+  and   rbx ,  r10 ; step 0
+  and   rax ,  rbx ; step 1
+  mov   rax ,  r10
+	test qword [rbp-104], rbx
+  cmp   rdx ,  r9
+	xor rdx,1171
+	add qword [rbp-80], rdx ; step 6
+  and   rdi ,  rbx ; step 7
+  sub   rdx ,  rdi
+.l8:	jnz .l8
+  sub   rdx ,  rbx
+  xor   rax ,  r8
+
+  imul   rdx ,  rax
+  xor   rdi ,  rbx
+	and qword [rbp-96], rsi ; step 13
+  sub   rdi ,  rsi
+	lea rax, [rbx+344]
+        pop rbp
+        ret
+
+func_63:
+        push rbp
+        mov rbp,rsp
+	lea rsi, [r8+328] ; step 0
+  imul   rcx ,  rdx
+	lea r11, [rdi+448]
+  imul   rbx ,  rsi ; step 3
+.l3:	jnz .l3
+	test rdi,838
+  or   r10 ,  rdx ; step 5
+	lea rbx, [r8+152] ; step 6
+.l6:	jnz .l6
+	xor qword [rbp-40], rdx
+  sub   rcx ,  rdi
+.l8:	jnz .l8
+
+	test r9,3670
+	mov rax,1820
+	imul rsi,877
+        pop rbp
+        ret
+
+func_64:
+        push rbp
+        mov rbp,rsp
+	lea rbx, [rdi+232] ; step 0
+  imul   rax ,  rbx
+
+	lea rdi, [rsi+168]
+  or   r11 ,  r10
+	test rdi,619 ; step 4
+  xor   rax ,  r11
+  imul   r8 ,  r11
+  imul   rsi ,  rdi ; step 7
+  test   rcx ,  r11
+        pop rbp
+        ret
+
+func_65:
+        push rbp
+        mov rbp,rsp
+	lea rcx, [rdx+184]
+	imul r11,3709
+.l1:	jnz .l1
+  and   r8 ,  rdx ; step 2
+
+	cmp qword [rbp-56], r11
+  add   r10 ,  r9
+.l4:	jnz .l4
+  or   rax ,  rdx
+  test   r9 ,  rbx
+.l6:	jnz .l6
+	or qword [rbp-64], r8 ; step 7
+.l7:	jnz .l7
+        pop rbp
+        ret
+
+func_66:
+        push rbp
+        mov rbp,rsp
+	cmp rdi,2509 ; step 0
+	mov r10,1727
+  cmp   r10 ,  r8
+  xor   rcx ,  r10
+  or   r8 ,  rcx ; step 4
+  mov   r8 ,  rcx
+  cmp   r10 ,  rdx
+	cmp qword [rbp-96], rsi
+  and   rsi ,  r10 ; step 8
+	test rax,718 ; step 9
+        pop rbp
+        ret
+
+func_67:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-16], r8
+.l0:	jnz .l0
+  test   rax ,  rcx
+	sub rdx,141
+	or qword [rbp-88], rdi
+  sub   rbx ,  rax
+  xor   r8 ,  r10
+	mov r9,23
+	or rdi,2205 ; step 7
+  mov   r11 ,  r8
+.l8:	jnz .l8
+  test   rbx ,  rax ; step 9
+
+  mov   r10 ,  rdx
+  or   rcx ,  rdx ; step 11
+  and   rbx ,  r11 ; step 12
+	and rdi,1958
+	mov qword [rbp-8], rax
+        pop rbp
+        ret
+
+func_68:
+        push rbp
+        mov rbp,rsp
+  xor   r11 ,  rbx
+  imul   rsi ,  rcx ; step 1
+	lea rdi, [r10+432] ; step 2
+.l2:	jnz .l2
+  and   r11 ,  r8
+	lea r11, [r9+224]
+	or rsi,823
+  or   rsi ,  rbx
+	lea r9, [r10+504]
+  add   r10 ,  rdx
+  mov   rax ,  r8
+  or   rcx ,  rbx ; step 10
+.l10:	jnz .l10
+	or qword [rbp-56], rsi
+  xor   r9 ,  rcx
+  or   rcx ,  rdi
+        pop rbp
+        ret
+
+func_69:
+        push rbp
+        mov rbp,rsp
+	sub rsi,210
+.l0:	jnz .l0
+	test qword [rbp-48], r8
+	add qword [rbp-80], rdi
+  test   rax ,  r9
+
+  mov   rax ,  r11
+
+	mov qword [rbp-48], rsi ; step 5
+	lea rdx, [rcx+96]
+  test   rax ,  r10 ; step 7
+  or   rdi ,  r8 ; step 8
+  or   rcx ,  rbx
+  imul   rcx ,  rsi
+
+        pop rbp
+        ret
+
+func_70:
+        push rbp
+        mov rbp,rsp
+	mov r9,954
+  mov   r11 ,  rsi
+  cmp   rsi ,  rdi ; step 2
+  xor   r9 ,  r10
+  and   rax ,  rbx
+	sub qword [rbp-16], r9 ; step 5
+	or r9,3994
+  and   r9 ,  rcx
+	cmp qword [rbp-32], r11 ; step 8
+	or rbx,542
+        pop rbp
+        ret
+
+func_71:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-120], r9
+  mov   rbx ,  rcx
+	cmp r10,220
+.l2:	jnz .l2
+	lea rcx, [r9+416] ; step 3
+  cmp   rdi ,  r9
+
+	lea r10, [rbx+408]
+	xor qword [rbp-32], rcx ; step 6
+  mov   rsi ,  rax
+	cmp qword [rbp-72], rdi ; step 8
+        pop rbp
+        ret
+
+func_72:
+        push rbp
+        mov rbp,rsp
+  imul   r10 ,  rbx ; step 0
+  sub   r10 ,  r9
+  xor   rcx ,  r8
+.l2:	jnz .l2
+	mov rdi,2022
+  cmp   rcx ,  rdi
+	lea r10, [r11+224]
+  sub   rbx ,  rdx
+.l6:	jnz .l6
+  mov   rax ,  r10
+	test qword [rbp-104], r11
+	cmp qword [rbp-40], rdx ; step 9
+	and qword [rbp-72], rbx ; step 10
+	add qword [rbp-24], rdx ; step 11
+  cmp   rdx ,  rdi
+.l12:	jnz .l12
+  or   rsi ,  rdi
+	sub qword [rbp-112], r11
+	cmp r10,1614 ; step 15
+	or qword [rbp-88], rsi ; step 16
+        pop rbp
+        ret
+
+func_73:
+        push rbp
+        mov rbp,rsp
+  test   rcx ,  r8
+  mov   r9 ,  rdi
+
+  cmp   r11 ,  r9
+.l2:	jnz .l2
+  add   rsi ,  rcx ; step 3
+	mov rdx,2174
+  cmp   rax ,  r10 ; step 5
+	xor qword [rbp-72], rdi
+	cmp qword [rbp-112], r10
+.l7:	jnz .l7
+  mov   rax ,  rdx ; step 8
+        pop rbp
+        ret
+
+func_74:
+        push rbp
+        mov rbp,rsp
+	mov rcx,2093 ; step 0
+	lea rbx, [rcx+144]
+	mov qword [rbp-48], r10
+  imul   rax ,  r11
+  mov   rdx ,  rbx
+.l4:	jnz .l4
+  and   r10 ,  rax
+	xor rcx,2384
+	lea rbx, [rax+208]
+  xor   r9 ,  rdi
+	or qword [rbp-16], r9 ; step 9
+
+	lea rcx, [rax+232] ; step 10
+        pop rbp
+        ret
+
+func_75:
+        push rbp
+        mov rbp,rsp
+	and qword [rbp-40], rcx
+	mov qword [rbp-16], r8
+	sub qword [rbp-40], rdi
+  imul   rsi ,  r10
+  cmp   rdx ,  rbx
+  mov   rbx ,  r9
+	lea rcx, [r11+424] ; step 6
+
+  or   r8 ,  rcx ; step 7
+	lea r10, [rbx+448] ; step 8
+        pop rbp
+        ret
+
+func_76:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  rdx
+
+  or   r9 ,  r10
+  add   rax ,  rcx
+	cmp rbx,2841 ; step 3
+  and   r10 ,  rdx ; step 4
+	cmp qword [rbp-104], r8 ; step 5
+  or   r11 ,  rdi ; step 6
+	xor r11,2347
+  or   rax ,  rcx
+	test r10,134
+  cmp   rsi ,  rbx
+	mov qword [rbp-128], rdx
+        pop rbp
+        ret
+
+func_77:
+        push rbp
+        mov rbp,rsp
+	and rsi,3686
+	mov r10,1823 ; step 1
+	and qword [rbp-72], r9 ; step 2
+  sub   rcx ,  r10 ; step 3
+  cmp   r8 ,  rdx
+  or   rdx ,  r11 ; step 5
+  add   rdx ,  rbx
+	mov rbx,3234
+        pop rbp
+        ret
+
+func_78:
+        push rbp
+        mov rbp,rsp
+  imul   rdx ,  rsi
+  and   rcx ,  r10 ; step 1
+.l1:	jnz .l1
+  sub   r11 ,  rax
+
+  test   r10 ,  rcx
+  xor   rcx ,  r9
+
+  or   rdx ,  r8 ; step 5
+  add   rax ,  rsi ; step 6
+	imul qword [rbp-72], r11
+	or rdi,1420
+  test   r8 ,  r11
+  imul   rax ,  rsi
+
+        pop rbp
+        ret
+
+func_79:
+        push rbp
+        mov rbp,rsp
+	cmp rdi,1600
+.l0:	jnz .l0
+	cmp rax,1662
+  mov   r11 ,  rdx
+	or qword [rbp-104], rsi ; step 3
+
+  cmp   rax ,  rbx
+  imul   r9 ,  rcx ; step 5
+	imul rdi,1804 ; step 6
+  and   rdi ,  r11
+
+  xor   rsi ,  rax
+  or   r11 ,  rsi ; step 9
+	lea r10, [rsi+88]
+  xor   rdx ,  rdi ; step 11
+.l11:	jnz .l11
+  cmp   rbx ,  rsi ; step 12
+	imul qword [rbp-104], rsi
+        pop rbp
+        ret
+
+func_80:
+        push rbp
+        mov rbp,rsp
+  mov   rsi ,  r9
+  sub   r9 ,  r11 ; step 1
+  cmp   rbx ,  rdx
+  cmp   r8 ,  rbx
+	add qword [rbp-104], rdi
+  or   rcx ,  r10
+	xor qword [rbp-112], r11
+
+  xor   rbx ,  r8
+  or   r11 ,  rdx ; step 8
+	add qword [rbp-80], rbx
+  test   rdx ,  rax ; step 10
+	lea rdx, [r8+88]
+  add   rbx ,  rdi
+  test   rdx ,  r11 ; step 13
+  mov   r11 ,  rcx
+	xor r11,2070
+	and qword [rbp-96], r8 ; step 16
+  or   rdx ,  r10
+  and   rax ,  r11 ; step 18
+        pop rbp
+        ret
+
+func_81:
+        push rbp
+        mov rbp,rsp
+	lea r9, [r11+296]
+	and r9,1225
+
+	cmp rdi,1914
+  and   r8 ,  rsi
+  and   rax ,  r9
+  xor   r11 ,  rdi ; step 5
+  test   r10 ,  rdi ; step 6
+	or rax,794 ; step 7
+        pop rbp
+        ret
+
+func_82:
+        push rbp
+        mov rbp,rsp
+  or   rcx ,  rdx ; step 0
+  mov   rsi ,  r11
+  mov   rdi ,  r9
+	cmp rcx,3647 ; step 3
+  xor   rdi ,  r8
+	and qword [rbp-48], rcx
+  xor   rcx ,  r10
+  test   rbx ,  rax
+	sub qword [rbp-40], r10
+        pop rbp
+        ret
+
+func_83:
+        push rbp
+        mov rbp,rsp
+	add r11,100
+	test rbx,201
+  add   rsi ,  rax
+  imul   rsi ,  rcx ; step 3
+  add   rdi ,  rax
+  xor   rax ,  r11 ; step 5
+  mov   r10 ,  r9 ; step 6
+  and   r9 ,  r10
+  test   r8 ,  rdx
+	test r9,1569 ; step 9
+
+	sub qword [rbp-112], r10 ; step 10
+  mov   r10 ,  rdi
+	lea rsi, [r11+384]
+  and   rsi ,  rdi ; step 13
+  test   rcx ,  rdi
+  add   r9 ,  r11
+.l15:	jnz .l15
+  xor   rdx ,  rcx
+	sub qword [rbp-16], r11
+        pop rbp
+        ret
+
+func_84:
+        push rbp
+        mov rbp,rsp
+  or   rax ,  rdi ; step 0
+	sub r10,803 ; step 1
+	lea rbx, [rcx+416]
+  test   r9 ,  r11
+  or   rdi ,  rbx
+	add qword [rbp-8], rcx ; step 5
+	add rdx,2391
+  add   r11 ,  r8 ; step 7
+  add   rax ,  rdi ; step 8
+  xor   r9 ,  rdx
+  mov   rax ,  rbx ; step 10
+	add r8,325 ; step 11
+	or qword [rbp-80], rcx
+	mov qword [rbp-16], rdx
+        pop rbp
+        ret
+
+func_85:
+        push rbp
+        mov rbp,rsp
+	and r10,1515
+.l0:	jnz .l0
+	sub rax,1836
+  xor   rdx ,  rsi
+  imul   rcx ,  r8
+  sub   r8 ,  r11
+  sub   r10 ,  rdx ; step 5
+
+	or rax,1177
+	cmp rbx,1599
+  and   rax ,  r11
+.l8:	jnz .l8
+  sub   r8 ,  rdx ; step 9
+
+  sub   rdx ,  r9
+  or   r8 ,  rax
+.l11:	jnz .l11
+	cmp qword [rbp-56], rax
+  imul   rcx ,  rax
+	lea rcx, [rsi+400] ; step 14
+        pop rbp
+        ret
+
+func_86:
+        push rbp
+        mov rbp,rsp
+	xor rsi,3647 ; step 0
+
+	cmp rcx,399
+  cmp   rdx ,  r8
+  imul   r8 ,  rsi
+.l3:	jnz .l3
+	cmp r9,1159 ; step 4
+.l4:	jnz .l4
+  or   r8 ,  rax ; step 5
+	cmp qword [rbp-96], r8
+  imul   r8 ,  rdi
+	xor qword [rbp-16], rbx
+	xor qword [rbp-24], rbx
+	mov rcx,382
+	lea rax, [r9+232]
+  or   r8 ,  rax
+.l12:	jnz .l12
+	cmp qword [rbp-96], r9
+
+  imul   r10 ,  r11
+	add qword [rbp-96], r11 ; step 15
+	add r9,414
+        pop rbp
+        ret
+
+func_87:
+        push rbp
+        mov rbp,rsp
+	sub qword [rbp-64], r11
+	mov rcx,1954 ; step 1
+
+  sub   r9 ,  rax ; step 2
+.l2:	jnz .l2
+  or   r9 ,  r11
+	lea r10, [rdx+416]
+  test   r9 ,  rdx
+  sub   rbx ,  rdi
+.l6:	jnz .l6
+  mov   r10 ,  rdi ; step 7
+	lea rdi, [rdx+336] ; step 8
+  sub   r8 ,  rdi
+        pop rbp
+        ret
+
+func_88:
+        push rbp
+        mov rbp,rsp
+  or   rbx ,  rdi
+	sub qword [rbp-64], r11 ; step 1
+	and qword [rbp-48], r9
+	lea r11, [r8+288]
+	sub r11,725
+  mov   rdx ,  rdi
+  mov   r10 ,  rdi
+	or qword [rbp-80], rbx ; step 7
+	test r8,3068
+  or   rax ,  rcx ; step 9
+  mov   r9 ,  rdi
+  xor   rax ,  rsi ; step 11
+.l11:	jnz .l11
+
+	test qword [rbp-88], rdi ; step 12
+	lea rbx, [r8+320]
+  and   rax ,  rsi ; step 14
+  sub   rbx ,  r10
+  or   r8 ,  r9 ; step 16
+	lea rdi, [rax+192]
+  and   r9 ,  r8
+	imul qword [rbp-24], r9 ; step 19
+.l19:	jnz .l19
+        pop rbp
+        ret
+
+func_89:
+        push rbp
+        mov rbp,rsp
+	lea r8, [rcx+184] ; step 0
+  mov   rdx ,  r8
+.l1:	jnz .l1
+	xor rdi,1906
+.l2:	jnz .l2
+  test   rsi ,  rdx
+	test qword [rbp-128], rsi
+	test qword [rbp-56], rax
+
+  add   rsi ,  r8 ; step 6
+  add   r8 ,  rdx ; step 7
+	lea r10, [rdi+88]
+	test rdx,2126 ; step 9
+	imul qword [rbp-96], rsi
+  and   r9 ,  rdx ; step 11
+	lea rbx, [rdi+224]
+	lea r10, [rdi+320]
+  and   rsi ,  r9
+
+  imul   rsi ,  rax
+
+  cmp   rdx ,  rdi ; step 16
+  and   rbx ,  r10
+        pop rbp
+        ret
+
+func_90:
+        push rbp
+        mov rbp,rsp
+	lea r9, [rsi+432]
+  mov   rdx ,  r8
+  or   r8 ,  rcx
+	or rbx,1091 ; step 3
+	sub qword [rbp-8], r8
+	lea r9, [r11+448]
+  add   rax ,  r10
+	mov rdi,2873 ; step 7
+  cmp   rsi ,  r10
+  xor   rdx ,  r8
+        pop rbp
+        ret
+
+func_91:
+        push rbp
+        mov rbp,rsp
+  mov   rbx ,  r11
+.l0:	jnz .l0
+
+  mov   rbx ,  rax
+  xor   r10 ,  r11 ; step 2
+  cmp   rax ,  r11
+  and   rdi ,  rsi
+  or   rbx ,  rsi
+	imul rdx,3104
+  imul   rbx ,  r10
+        pop rbp
+        ret
+
+func_92:
+        push rbp
+        mov rbp,rsp
+	and qword [rbp-64], rdi
+  add   rsi ,  rdx
+	and qword [rbp-48], rdx
+	add qword [rbp-96], rsi ; step 3
+  cmp   r9 ,  rbx ; step 4
+  or   rax ,  r11 ; step 5
+  xor   r11 ,  rcx
+
+	or r9,3185 ; step 7
+.l7:	jnz .l7
+  sub   rdi ,  rsi ; step 8
+  or   r10 ,  rdx
+  and   rdx ,  r8
+  xor   rsi ,  r8 ; step 11
+  or   rdx ,  r10 ; step 12
+.l12:	jnz .l12
+  and   rax ,  r11 ; step 13
+  test   rax ,  r10
+  cmp   r10 ,  rbx
+  and   r8 ,  rdx
+        pop rbp
+        ret
+
+func_93:
+        push rbp
+        mov rbp,rsp
+  cmp   r10 ,  r9
+	mov rdx,3008 ; step 1
+  test   r11 ,  rax
+	imul qword [rbp-128], rcx
+	add rcx,775
+	imul qword [rbp-104], r8 ; step 5
+	lea r8, [rbx+448] ; step 6
+  cmp   rax ,  r11 ; step 7
+  mov   r11 ,  r9
+	sub r11,2626
+.l9:	jnz .l9
+  mov   rdx ,  rdi
+	xor r10,2232
+  and   rdx ,  rcx ; step 12
+  add   rax ,  rdi ; step 13
+  test   r11 ,  rdi ; step 14
+	and r8,1534
+  xor   r9 ,  r11
+.l16:	jnz .l16
+        pop rbp
+        ret
+
+func_94:
+        push rbp
+        mov rbp,rsp
+  or   rdx ,  rdi ; step 0
+
+  sub   rax ,  r9
+  and   r10 ,  rdi
+	lea r8, [rdx+408]
+
+	or r11,4001
+	test qword [rbp-48], r9
+  xor   rbx ,  rax
+  imul   rdx ,  rcx ; step 7
+        pop rbp
+        ret
+
+func_95:
+        push rbp
+        mov rbp,rsp
+  test   rdi ,  r10 ; step 0
+.l0:	jnz .l0
+  or   rsi ,  r10
+	add r10,1998 ; step 2
+  imul   rdi ,  rbx
+  sub   r9 ,  rdi
+
+	lea rsi, [r8+32]
+  or   rsi ,  rdx
+  xor   rsi ,  rdx
+	lea rax, [rdx+352]
+	sub qword [rbp-8], r9
+	test qword [rbp-72], r10
+.l10:	jnz .l10
+  sub   r10 ,  rcx
+        pop rbp
+        ret
+
+func_96:
+        push rbp
+        mov rbp,rsp
+  test   rdi ,  rbx ; step 0
+  mov   rdi ,  rbx
+	xor rax,2921
+.l2:	jnz .l2
+	and qword [rbp-56], rax ; step 3
+  mov   r8 ,  rax
+	cmp r10,1725
+.l5:	jnz .l5
+  add   rbx ,  rax
+  test   rax ,  rdi ; step 7
+  mov   rdi ,  r10
+.l8:	jnz .l8
+	add rsi,1613
+  or   r8 ,  rcx
+	mov rax,2143 ; step 11
+  xor   rcx ,  rax
+  sub   r10 ,  rax
+.l13:	jnz .l13
+  imul   r11 ,  rax
+        pop rbp
+        ret
+
+func_97:
+        push rbp
+        mov rbp,rsp
+	cmp r8,3365
+.l0:	jnz .l0
+	or qword [rbp-48], r8
+.l1:	jnz .l1
+
+  xor   rdx ,  r10
+.l2:	jnz .l2
+	xor rbx,2604
+.l3:	jnz .l3
+
+  mov   r10 ,  r8 ; step 4
+	add rsi,2501
+	lea r8, [r9+288]
+	mov qword [rbp-48], rdi
+  mov   r10 ,  rdx
+.l8:	jnz .l8
+  test   r11 ,  r8
+        pop rbp
+        ret
+
+func_98:
+        push rbp
+        mov rbp,rsp
+	lea r8, [rdi+400] ; step 0
+	imul rdi,3200
+.l1:	jnz .l1
+	lea r8, [rbx+336] ; step 2
+.l2:	jnz .l2
+	mov rsi,2173
+.l3:	jnz .l3
+  mov   rcx ,  rsi
+.l4:	jnz .l4
+	mov r10,1673 ; step 5
+  cmp   r8 ,  rdx
+	imul rdx,1726 ; step 7
+  imul   r10 ,  rcx ; step 8
+  sub   rdi ,  r11 ; step 9
+
+	imul rax,1092
+	add rdi,1260
+  add   rdx ,  r9
+        pop rbp
+        ret
+
+func_99:
+        push rbp
+        mov rbp,rsp
+  add   r9 ,  rbx
+  or   rax ,  rdi
+  add   rcx ,  rsi
+	xor r11,2709
+  sub   rbx ,  r11
+  test   rdi ,  rbx
+
+	xor r10,1819
+
+	cmp qword [rbp-56], r8
+	add qword [rbp-128], rax
+.l8:	jnz .l8
+        pop rbp
+        ret
+
+func_100:
+        push rbp
+        mov rbp,rsp
+  sub   rcx ,  rax
+	test qword [rbp-72], rsi
+	lea rdx, [r9+272]
+  add   rsi ,  rcx ; step 3
+	cmp rcx,2183
+  cmp   rdi ,  rsi
+  test   rax ,  rsi
+	add rsi,2952
+  sub   rbx ,  rdx ; step 8
+  xor   r9 ,  rdx ; step 9
+	lea r9, [rcx+504]
+	and qword [rbp-24], rdi ; step 11
+  test   r8 ,  r11 ; step 12
+	or rsi,372
+	or qword [rbp-120], rcx ; step 14
+	lea r8, [r10+408]
+  mov   rcx ,  rdi ; step 16
+  mov   rax ,  rsi ; step 17
+        pop rbp
+        ret
+
+func_101:
+        push rbp
+        mov rbp,rsp
+  imul   r8 ,  rdx ; step 0
+  and   rdi ,  r10
+  or   rax ,  rdi ; step 2
+  imul   rax ,  r11
+	lea rax, [r8+448]
+  mov   rcx ,  rsi
+	lea rax, [r9+72]
+	mov qword [rbp-24], r10 ; step 7
+  or   rdx ,  rsi
+	lea rdi, [rcx+208] ; step 9
+	and r9,1433 ; step 10
+  imul   rcx ,  rsi
+	mov qword [rbp-48], r10
+  and   rax ,  rcx
+.l13:	jnz .l13
+
+	mov r11,3529
+  mov   rax ,  rdi
+        pop rbp
+        ret
+
+func_102:
+        push rbp
+        mov rbp,rsp
+  add   rcx ,  r11
+	imul qword [rbp-96], rbx
+	xor rsi,3375
+	sub qword [rbp-96], rdx
+
+  sub   rdi ,  rsi ; step 4
+	lea rdi, [rdx+160] ; step 5
+  xor   rbx ,  rsi
+  mov   rsi ,  rdi
+        pop rbp
+        ret
+
+func_103:
+        push rbp
+        mov rbp,rsp
+	test rcx,3728 ; step 0
+  sub   rcx ,  rbx ; step 1
+  imul   r9 ,  rdx
+	or qword [rbp-80], rbx
+  or   r8 ,  rsi
+	add qword [rbp-104], r9
+
+	sub qword [rbp-64], rdi
+	imul rax,2058
+	cmp qword [rbp-88], rdx
+  imul   rax ,  r11
+  imul   rsi ,  rbx ; step 10
+  test   r9 ,  rax
+
+  or   rsi ,  rdi
+        pop rbp
+        ret
+
+func_104:
+        push rbp
+        mov rbp,rsp
+  sub   rcx ,  rdx
+
+  xor   rdi ,  r8
+	xor qword [rbp-112], rdx
+  sub   rdi ,  rcx ; step 3
+	cmp qword [rbp-88], rbx
+	cmp r9,462
+  sub   rcx ,  rdi
+  sub   r11 ,  rdi
+  imul   r11 ,  rcx
+	sub rsi,3148 ; step 9
+	imul qword [rbp-112], rbx ; step 10
+.l10:	jnz .l10
+	imul r9,188
+	or r8,1426 ; step 12
+  imul   rax ,  rbx
+  mov   rdi ,  r9
+  cmp   rdx ,  rbx
+.l15:	jnz .l15
+	lea rsi, [r8+440] ; step 16
+        pop rbp
+        ret
+
+func_105:
+        push rbp
+        mov rbp,rsp
+	lea rcx, [rdi+112] ; step 0
+  test   r8 ,  rsi
+
+  add   rbx ,  rdi
+.l2:	jnz .l2
+	lea rbx, [rax+472]
+	and qword [rbp-56], rdi
+
+	lea rbx, [r11+120]
+	and r8,1574
+
+  xor   rdx ,  r11
+.l7:	jnz .l7
+  mov   rdx ,  rax
+	add rdx,2836 ; step 9
+  and   rax ,  rdx ; step 10
+  test   rdx ,  rbx ; step 11
+	test rbx,7
+	and rcx,2790
+	lea rsi, [r10+448] ; step 14
+.l14:	jnz .l14
+
+        pop rbp
+        ret
+
+func_106:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  rdx
+  xor   rsi ,  rbx
+  test   r11 ,  rsi
+  sub   r11 ,  rcx ; step 3
+
+	mov qword [rbp-128], r11
+
+  mov   rax ,  rbx
+  xor   rbx ,  r11 ; step 6
+	and rcx,1045
+	cmp r9,3727 ; step 8
+        pop rbp
+        ret
+
+func_107:
+        push rbp
+        mov rbp,rsp
+  test   r11 ,  rdx ; step 0
+  mov   r11 ,  r9
+  imul   rsi ,  rcx
+  imul   r11 ,  rax
+  cmp   rcx ,  r10
+	lea r11, [rdi+392]
+  mov   rdx ,  r8
+  xor   r8 ,  rbx
+	cmp qword [rbp-88], r9
+  mov   rsi ,  r10
+	or rsi,3213
+  and   rax ,  rdi
+  cmp   r9 ,  r10 ; step 12
+  or   rax ,  r8
+
+  or   r11 ,  r9
+	and rcx,3639
+.l15:	jnz .l15
+        pop rbp
+        ret
+
+func_108:
+        push rbp
+        mov rbp,rsp
+	lea rax, [r11+464]
+	mov rdx,1118
+	mov qword [rbp-40], rdi
+  xor   r8 ,  rsi
+  sub   rdi ,  rax
+.l4:	jnz .l4
+  sub   r8 ,  rbx
+	mov rdx,1536
+.l6:	jnz .l6
+  add   rdx ,  rax
+.l7:	jnz .l7
+  mov   r10 ,  r8
+  imul   rdi ,  r9
+  and   r10 ,  rcx
+	sub rbx,572 ; step 11
+  cmp   r11 ,  rsi
+	imul qword [rbp-40], r8
+	or qword [rbp-104], rdx
+	and qword [rbp-72], rdx
+
+  add   rdx ,  r8
+	lea rdx, [r11+512]
+
+	mov qword [rbp-88], r9 ; step 18
+        pop rbp
+        ret
+
+func_109:
+        push rbp
+        mov rbp,rsp
+  mov   rsi ,  r10 ; step 0
+  cmp   rax ,  r8
+  or   r9 ,  r8 ; step 2
+  add   rdx ,  r8 ; step 3
+	lea rcx, [r10+440]
+  cmp   r11 ,  rdx
+.l5:	jnz .l5
+  and   r8 ,  rdi
+	test rcx,1832
+
+  cmp   rdi ,  r11
+  xor   r9 ,  r8
+  sub   rdx ,  rcx ; step 10
+  test   r9 ,  rbx ; step 11
+  imul   r11 ,  r9
+
+	imul rsi,2500
+        pop rbp
+        ret
+
+func_110:
+        push rbp
+        mov rbp,rsp
+	or qword [rbp-24], r9
+	cmp qword [rbp-40], rdi
+
+  imul   rbx ,  r9
+	test qword [rbp-8], r8
+.l3:	jnz .l3
+  and   rcx ,  rax
+  or   r8 ,  r10 ; step 5
+  mov   r8 ,  rdi
+  sub   r9 ,  rdx
+  mov   r8 ,  rsi
+  xor   r9 ,  rax
+  and   r11 ,  rdx ; step 10
+  cmp   rdx ,  r11
+        pop rbp
+        ret
+
+func_111:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-80], rbx
+  mov   r8 ,  rcx ; step 1
+	cmp qword [rbp-32], rbx ; step 2
+	lea r10, [rcx+456]
+	lea r10, [r9+384]
+  test   rsi ,  r11 ; step 5
+	lea r10, [r8+112]
+	test rbx,3821
+  test   rbx ,  r11
+  add   r8 ,  rax
+        pop rbp
+        ret
+
+func_112:
+        push rbp
+        mov rbp,rsp
+	and rdi,1276
+.l0:	jnz .l0
+
+	and qword [rbp-32], rbx
+
+  imul   r10 ,  r11 ; step 2
+  test   rcx ,  r11
+  cmp   r11 ,  r9
+.l4:	jnz .l4
+	test rdi,1553
+	lea rcx, [r9+440]
+  xor   rax ,  rsi ; step 7
+.l7:	jnz .l7
+  sub   r10 ,  rdx
+        pop rbp
+        ret
+
+func_113:
+        push rbp
+        mov rbp,rsp
+  and   rsi ,  rax
+	and r10,2679 ; step 1
+	imul qword [rbp-8], r8
+  sub   r9 ,  rax
+  sub   rcx ,  r10
+  add   r11 ,  r10 ; step 5
+
+  add   rdi ,  r8
+  add   rsi ,  r9
+  imul   r9 ,  rdx
+        pop rbp
+        ret
+
+func_114:
+        push rbp
+        mov rbp,rsp
+  mov   rbx ,  rdx
+.l0:	jnz .l0
+  sub   rcx ,  rdi ; step 1
+	imul qword [rbp-112], rsi
+  imul   rdx ,  rcx
+.l3:	jnz .l3
+	xor rbx,1762
+	sub r8,3463
+  add   r9 ,  r10 ; step 6
+	or qword [rbp-128], r8
+  mov   r10 ,  rax
+  xor   r11 ,  rbx ; step 9
+  xor   rdi ,  rsi ; step 10
+	and rsi,165
+  imul   rdx ,  rsi ; step 12
+
+  xor   rax ,  r9
+        pop rbp
+        ret
+
+func_115:
+        push rbp
+        mov rbp,rsp
+	sub rdi,2825
+  xor   rsi ,  rdx
+.l1:	jnz .l1
+  add   rcx ,  rax
+	mov rax,2509
+  and   r10 ,  rdi
+
+  and   rbx ,  rsi
+	lea rax, [rdx+440] ; step 6
+  and   rdi ,  rbx ; step 7
+  or   r10 ,  rdi
+	or r11,1192 ; step 9
+	add rbx,3088
+  and   r8 ,  rdi ; step 11
+	cmp r8,3215
+
+	test rbx,2232 ; step 13
+	lea rdi, [r11+264]
+	add rdi,2101 ; step 15
+	lea r10, [r9+40]
+	lea rdi, [rcx+424]
+  cmp   rdx ,  rdi
+.l18:	jnz .l18
+        pop rbp
+        ret
+
+func_116:
+        push rbp
+        mov rbp,rsp
+  add   rdx ,  rax
+  mov   r9 ,  r10
+  cmp   r10 ,  r11 ; step 2
+	test qword [rbp-80], rax
+  and   r11 ,  rdi ; step 4
+	lea r9, [rsi+64] ; step 5
+  or   rcx ,  rax ; step 6
+  sub   rbx ,  r11
+	test rcx,2529
+  test   r9 ,  rbx
+  xor   rdi ,  rsi
+  imul   r8 ,  rdi
+  and   rsi ,  r9
+	and r10,4074
+
+  test   rdx ,  r8
+  sub   rdx ,  r11 ; step 15
+  or   rax ,  r8
+        pop rbp
+        ret
+
+func_117:
+        push rbp
+        mov rbp,rsp
+	sub qword [rbp-128], r8 ; step 0
+  and   rbx ,  r8
+  sub   rax ,  r10 ; step 2
+.l2:	jnz .l2
+  sub   rsi ,  rax
+
+  xor   rcx ,  rdi ; step 4
+	or rsi,2356
+	test r9,2668 ; step 6
+  test   rbx ,  r10
+	cmp rax,1362
+  mov   rdx ,  rax
+.l9:	jnz .l9
+  or   r11 ,  r10
+	and qword [rbp-32], rax ; step 11
+	or qword [rbp-128], rdx
+  and   rax ,  rcx
+  cmp   r11 ,  rdi ; step 14
+  imul   rcx ,  r9
+        pop rbp
+        ret
+
+func_118:
+        push rbp
+        mov rbp,rsp
+	imul r10,3077 ; step 0
+
+  imul   rax ,  r10
+  mov   r10 ,  rdx
+  mov   r11 ,  rbx ; step 3
+  test   rcx ,  rdi
+  or   rcx ,  r8
+	add qword [rbp-24], rbx
+  add   r11 ,  r8
+	xor r11,276
+
+  test   rdi ,  rsi
+  imul   r10 ,  rsi ; step 10
+	cmp rbx,638 ; step 11
+        pop rbp
+        ret
+
+func_119:
+        push rbp
+        mov rbp,rsp
+  add   rdx ,  rcx ; step 0
+	cmp qword [rbp-72], r8
+  cmp   rsi ,  r8 ; step 2
+  mov   r10 ,  rdi
+  and   rsi ,  rdi
+	or qword [rbp-8], rcx
+  imul   rbx ,  r9
+  and   r10 ,  rsi
+  add   rcx ,  r11
+	lea rcx, [r11+424]
+	mov qword [rbp-88], rdx
+        pop rbp
+        ret
+
+func_120:
+        push rbp
+        mov rbp,rsp
+	xor rdx,2535
+  cmp   rax ,  rsi
+  mov   r10 ,  rsi
+
+  xor   r8 ,  r11
+.l3:	jnz .l3
+  xor   rdx ,  rcx
+  or   r8 ,  rax
+  imul   r8 ,  r9
+
+	lea rdi, [rcx+328] ; step 7
+
+  or   rdx ,  rbx
+  or   rcx ,  rbx
+  or   rsi ,  r10
+  xor   r10 ,  rsi
+	add r10,441
+  mov   rsi ,  r9
+  add   r9 ,  rdx
+	sub r8,3232
+  test   rsi ,  rdx
+	sub r9,2700
+  add   rdx ,  r10
+  cmp   r10 ,  rbx
+        pop rbp
+        ret
+
+func_121:
+        push rbp
+        mov rbp,rsp
+	lea rdx, [rdi+56]
+  imul   rsi ,  rax
+  mov   rdx ,  rax ; step 2
+  xor   r9 ,  rcx
+  add   rax ,  r11 ; step 4
+  imul   rbx ,  rdi ; step 5
+	lea rbx, [rax+496]
+  or   rax ,  r10
+  imul   rcx ,  r10 ; step 8
+  xor   r10 ,  rbx ; step 9
+  imul   rcx ,  r10
+        pop rbp
+        ret
+
+func_122:
+        push rbp
+        mov rbp,rsp
+  mov   r8 ,  rbx
+	mov r8,582
+  imul   rax ,  r8
+  imul   r10 ,  r9 ; step 3
+  or   rbx ,  rdi ; step 4
+  sub   r10 ,  rcx
+
+  sub   r11 ,  rsi
+	lea rdi, [rcx+88]
+	xor qword [rbp-112], r11
+	add qword [rbp-112], r9
+  sub   r9 ,  r10 ; step 10
+	lea rbx, [r8+24] ; step 11
+	xor r8,3898
+.l12:	jnz .l12
+  cmp   rdi ,  r11 ; step 13
+.l13:	jnz .l13
+	mov qword [rbp-48], rcx
+	lea r8, [r11+440] ; step 15
+	cmp rdi,991 ; step 16
+        pop rbp
+        ret
+
+func_123:
+        push rbp
+        mov rbp,rsp
+  cmp   rdi ,  rdx ; step 0
+	test qword [rbp-72], r11
+
+  and   rsi ,  r11 ; step 2
+  sub   rbx ,  rsi
+  or   rdi ,  r8 ; step 4
+
+	cmp qword [rbp-32], r10
+  add   r9 ,  rbx
+  test   rsi ,  rdx
+
+	sub rsi,2352
+  and   rdx ,  rcx ; step 9
+  sub   r8 ,  rsi
+  test   rdi ,  rcx
+	lea r9, [r8+144]
+	and qword [rbp-104], rdi
+	and qword [rbp-104], rcx
+	test rdx,712
+        pop rbp
+        ret
+
+func_124:
+        push rbp
+        mov rbp,rsp
+  or   r10 ,  r9
+	lea r9, [rdi+8]
+  or   rdi ,  rcx ; step 2
+	lea rsi, [rdi+136]
+  test   rbx ,  rax ; step 4
+	lea r9, [r10+168]
+	sub rbx,436 ; step 6
+	sub qword [rbp-120], rcx
+	imul qword [rbp-128], rsi ; step 8
+  cmp   rsi ,  r11
+  test   rdx ,  rsi
+  imul   rcx ,  r9
+  sub   r11 ,  rdi ; step 12
+  cmp   rax ,  r8
+	and qword [rbp-24], r10 ; step 14
+	lea rbx, [r9+504]
+	lea rcx, [rbx+136]
+	add rcx,405
+.l17:	jnz .l17
+	lea rdx, [rsi+144]
+        pop rbp
+        ret
+
+func_125:
+        push rbp
+        mov rbp,rsp
+	imul qword [rbp-56], r9 ; step 0
+	lea rbx, [r11+368]
+.l1:	jnz .l1
+	sub rbx,1467 ; step 2
+  or   r8 ,  rcx ; step 3
+  test   rdx ,  rcx ; step 4
+	lea r10, [rax+96]
+	lea r10, [r8+456]
+.l6:	jnz .l6
+  imul   r8 ,  r11
+  and   rdi ,  rsi
+  cmp   r10 ,  rax
+	xor rdx,1498 ; step 10
+  mov   rbx ,  rax
+	mov r8,2184
+	xor qword [rbp-120], rdx
+  test   rdx ,  r11 ; step 14
+  mov   r9 ,  r10
+	sub r9,3460
+  xor   rcx ,  rdi
+  test   rdi ,  r10 ; step 18
+        pop rbp
+        ret
+
+func_126:
+        push rbp
+        mov rbp,rsp
+	add rdx,1309
+  imul   rdi ,  rax
+  imul   rsi ,  rcx
+  sub   r10 ,  r9
+  sub   rsi ,  r9
+	add r11,1913 ; step 5
+  mov   r11 ,  rax
+  and   r10 ,  rbx ; step 7
+
+	lea rbx, [r11+0]
+  or   r11 ,  rcx
+        pop rbp
+        ret
+
+func_127:
+        push rbp
+        mov rbp,rsp
+  imul   rcx ,  r9 ; step 0
+	or rdx,3919 ; step 1
+
+  imul   r10 ,  rbx
+  and   rdi ,  r11 ; step 3
+  imul   r11 ,  r8 ; step 4
+  add   r9 ,  rax
+	lea rsi, [rdx+232]
+
+	and rsi,3510 ; step 7
+  test   rdi ,  r11 ; step 8
+	and rax,1316
+        pop rbp
+        ret
+
+func_128:
+        push rbp
+        mov rbp,rsp
+	cmp r11,955 ; step 0
+	lea r11, [rdi+32]
+	add rcx,1144 ; step 2
+	lea rbx, [rdi+0] ; step 3
+	lea rcx, [rbx+488] ; step 4
+	and rsi,1916 ; step 5
+	or rax,1442
+  sub   rdi ,  rbx
+  sub   r11 ,  rdi
+	or qword [rbp-40], rax
+	add rcx,3603
+	lea rbx, [rdx+384]
+
+        pop rbp
+        ret
+
+func_129:
+        push rbp
+        mov rbp,rsp
+	imul qword [rbp-16], r11 ; step 0
+.l0:	jnz .l0
+  add   rdi ,  rdx
+  imul   rcx ,  rdx
+.l2:	jnz .l2
+  imul   r10 ,  rdi
+	mov r9,511
+
+	lea r11, [rsi+48]
+.l5:	jnz .l5
+  or   r11 ,  r10
+.l6:	jnz .l6
+	cmp rbx,3470
+.l7:	jnz .l7
+  mov   rcx ,  r8
+.l8:	jnz .l8
+
+	xor rbx,1225 ; step 9
+  test   rbx ,  rcx
+	and rdx,2472
+	or qword [rbp-104], r9
+  test   rdx ,  rdi
+  xor   rbx ,  rcx
+
+	xor r11,1854 ; step 15
+	lea rsi, [rdx+208]
+	test r11,447 ; step 17
+
+  cmp   rbx ,  r10
+        pop rbp
+        ret
+
+func_130:
+        push rbp
+        mov rbp,rsp
+	add r11,1808
+	xor qword [rbp-112], rdx
+	sub r8,3233 ; step 2
+	sub qword [rbp-40], rsi
+  or   r9 ,  rdx
+  or   r9 ,  rax
+  or   rcx ,  rax
+  xor   rax ,  r11 ; step 7
+	cmp r11,2149 ; step 8
+  or   rdi ,  r10
+  mov   r10 ,  rbx
+  test   rdx ,  rbx
+        pop rbp
+        ret
+
+func_131:
+        push rbp
+        mov rbp,rsp
+  imul   r11 ,  rax ; step 0
+  mov   rsi ,  r8
+  xor   r9 ,  rax ; step 2
+.l2:	jnz .l2
+	xor qword [rbp-64], r9 ; step 3
+  or   rax ,  r11 ; step 4
+
+	lea rsi, [r11+8]
+  cmp   r11 ,  rbx
+  add   r11 ,  r9
+.l7:	jnz .l7
+
+  xor   rbx ,  rax ; step 8
+  imul   r9 ,  rsi
+  mov   r11 ,  r8 ; step 10
+	or rcx,3130
+        pop rbp
+        ret
+
+func_132:
+        push rbp
+        mov rbp,rsp
+	xor r8,900
+  or   rdx ,  rax ; step 1
+
+  imul   rdi ,  rsi
+	or qword [rbp-48], rdi
+
+	lea rbx, [r9+360] ; step 4
+  imul   rcx ,  rdx ; step 5
+	xor qword [rbp-40], rdi ; step 6
+	mov rsi,2897
+.l7:	jnz .l7
+	mov rbx,824
+	lea rsi, [rax+416]
+	and qword [rbp-24], rax
+	and rbx,2692 ; step 11
+
+	add rsi,2565
+        pop rbp
+        ret
+
+func_133:
+        push rbp
+        mov rbp,rsp
+	mov qword [rbp-40], rsi
+	test rax,2282
+	imul qword [rbp-80], r10
+	lea r9, [rdx+392]
+.l3:	jnz .l3
+  sub   r9 ,  r10
+  test   r10 ,  rcx
+  sub   r10 ,  r11 ; step 6
+	and qword [rbp-72], rcx
+
+  mov   r10 ,  rdi
+  or   r8 ,  rbx ; step 9
+  sub   rsi ,  rdx ; step 10
+  imul   rax ,  rdx
+	cmp rdx,3118
+  test   r8 ,  r10
+
+	test qword [rbp-104], rdi
+	cmp rdx,3496 ; step 15
+        pop rbp
+        ret
+
+func_134:
+        push rbp
+        mov rbp,rsp
+	sub r10,1245
+	imul rdi,3119
+  add   rbx ,  r9 ; step 2
+	and r11,3923 ; step 3
+  test   rax ,  r10
+	lea rdi, [rcx+320]
+	lea rax, [rsi+296] ; step 6
+.l6:	jnz .l6
+  cmp   r11 ,  r10
+  sub   rdi ,  rbx
+  test   r10 ,  r11
+.l9:	jnz .l9
+	mov qword [rbp-32], rdx
+	lea rdi, [rsi+376] ; step 11
+
+  add   r9 ,  r10 ; step 12
+  xor   rcx ,  r9
+  cmp   rbx ,  r9
+.l14:	jnz .l14
+  xor   r11 ,  rcx ; step 15
+.l15:	jnz .l15
+  imul   r8 ,  r9
+  imul   rbx ,  r10
+.l17:	jnz .l17
+        pop rbp
+        ret
+
+func_135:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  r8 ; step 0
+	sub rbx,3323
+  sub   rax ,  rcx
+	or qword [rbp-48], r10
+  xor   rax ,  r8
+	lea rdx, [rdi+280]
+	mov qword [rbp-104], rdx ; step 6
+  and   r11 ,  rbx ; step 7
+	or qword [rbp-64], r9
+  xor   rdx ,  rax ; step 9
+	sub qword [rbp-24], r10
+        pop rbp
+        ret
+
+func_136:
+        push rbp
+        mov rbp,rsp
+	lea rsi, [r8+248] ; step 0
+	add rax,1808 ; step 1
+  test   rbx ,  rsi
+  test   r11 ,  rcx
+  sub   rcx ,  rsi ; step 4
+  imul   r8 ,  rcx
+  and   rax ,  rdx
+	lea r8, [rdi+512] ; step 7
+  imul   rdx ,  rcx
+        pop rbp
+        ret
+
+func_137:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-104], r8 ; step 0
+  imul   rdi ,  rdx
+	sub r9,3109
+.l2:	jnz .l2
+	lea rdx, [r10+200] ; step 3
+  add   r11 ,  r8 ; step 4
+  imul   r8 ,  rcx ; step 5
+.l5:	jnz .l5
+
+	xor rdx,1833
+  xor   rcx ,  rsi
+	cmp qword [rbp-56], r8
+        pop rbp
+        ret
+
+func_138:
+        push rbp
+        mov rbp,rsp
+  and   r10 ,  rbx ; step 0
+  add   rbx ,  r11
+  test   rdi ,  rsi
+	and rbx,516
+.l3:	jnz .l3
+  test   r9 ,  rax
+
+  add   r8 ,  rcx ; step 5
+	test rax,1050 ; step 6
+	mov r8,1939
+
+	lea rbx, [r9+136]
+  add   rcx ,  rdi
+
+  test   rbx ,  rsi ; step 10
+  mov   rdx ,  rdi ; step 11
+  cmp   rcx ,  rdi ; step 12
+.l12:	jnz .l12
+	mov rdi,2580 ; step 13
+	xor r11,3416
+        pop rbp
+        ret
+
+func_139:
+        push rbp
+        mov rbp,rsp
+	and rcx,3256
+  or   r8 ,  rbx ; step 1
+  mov   rdi ,  rbx
+  sub   rsi ,  r9 ; step 3
+  or   rdi ,  r11 ; step 4
+  cmp   r9 ,  r11
+  mov   r10 ,  rax ; step 6
+  add   r8 ,  r10
+.l7:	jnz .l7
+  and   r11 ,  rdi
+.l8:	jnz .l8
+	or qword [rbp-32], rcx
+  and   rcx ,  r9
+.l10:	jnz .l10
+  sub   rsi ,  r9 ; step 11
+  sub   rax ,  r11 ; step 12
+  xor   rax ,  r10
+  imul   r10 ,  rax ; step 14
+  xor   rax ,  rcx
+
+        pop rbp
+        ret
+
+func_140:
+        push rbp
+        mov rbp,rsp
+  test   rsi ,  r11
+  or   rax ,  rcx
+  cmp   rdi ,  rsi ; step 2
+  mov   rcx ,  r11
+  and   r11 ,  rdi
+	cmp r11,569
+  test   rdx ,  r10
+	lea rdi, [rbx+128]
+  test   r11 ,  rbx ; step 8
+	lea r10, [rdx+288]
+  mov   rsi ,  rdi ; step 10
+	or rdx,3388
+  add   r8 ,  rax
+        pop rbp
+        ret
+
+func_141:
+        push rbp
+        mov rbp,rsp
+  and   rdi ,  r8
+  test   rcx ,  rsi
+	and rsi,3119
+.l2:	jnz .l2
+  and   r10 ,  rcx ; step 3
+	lea r9, [rax+488]
+  add   r9 ,  rdi
+.l5:	jnz .l5
+	cmp rdi,435 ; step 6
+.l6:	jnz .l6
+	sub rdx,1789 ; step 7
+	or rdx,3834
+.l8:	jnz .l8
+	lea rdx, [rdi+280]
+  or   r9 ,  rdx ; step 10
+  sub   rdx ,  r9
+        pop rbp
+        ret
+
+func_142:
+        push rbp
+        mov rbp,rsp
+  sub   r9 ,  r8 ; step 0
+	cmp rsi,3522 ; step 1
+  xor   rcx ,  r10
+	imul rax,3526 ; step 3
+	and r8,1209 ; step 4
+  imul   r9 ,  r10 ; step 5
+  add   rcx ,  rdi ; step 6
+	lea rdi, [rsi+336]
+.l7:	jnz .l7
+        pop rbp
+        ret
+
+func_143:
+        push rbp
+        mov rbp,rsp
+  test   rax ,  rdx ; step 0
+  or   rbx ,  r9
+	or rdx,3117
+  sub   r10 ,  rax
+	mov qword [rbp-112], r11
+.l4:	jnz .l4
+	mov rdx,3474 ; step 5
+	test qword [rbp-48], rdi
+.l6:	jnz .l6
+  xor   r10 ,  rsi
+	or rdx,140
+  xor   r8 ,  rsi
+.l9:	jnz .l9
+        pop rbp
+        ret
+
+func_144:
+        push rbp
+        mov rbp,rsp
+  add   rdx ,  r10
+	lea rax, [r10+64]
+  or   rcx ,  r11
+  add   rcx ,  r9
+  xor   rsi ,  rdx ; step 4
+	xor qword [rbp-16], r8
+	imul rcx,3801
+  mov   rsi ,  rbx ; step 7
+  and   r8 ,  r10
+  mov   rdx ,  rax
+	sub r8,1764
+  sub   rsi ,  rdx
+	test rbx,3106 ; step 12
+  sub   r9 ,  rsi
+        pop rbp
+        ret
+
+func_145:
+        push rbp
+        mov rbp,rsp
+  xor   r8 ,  rbx
+
+	sub qword [rbp-80], r8
+	test rdx,639
+  sub   r11 ,  r8
+  test   rsi ,  r10 ; step 4
+  and   rbx ,  r10
+  cmp   r9 ,  rsi
+	test r8,2258
+	and r11,1326
+  mov   r9 ,  rsi
+  add   rsi ,  rax ; step 10
+        pop rbp
+        ret
+
+func_146:
+        push rbp
+        mov rbp,rsp
+  cmp   rdx ,  rdi
+  or   rdi ,  rdx
+
+	or qword [rbp-24], rdi
+  imul   rcx ,  rbx
+	xor rdx,1073
+	xor r10,778
+  and   rbx ,  r9
+  or   rcx ,  rdi ; step 7
+  cmp   r11 ,  r8
+  sub   r11 ,  rdi ; step 9
+	lea rcx, [r10+288] ; step 10
+	mov rdx,1532
+  imul   r9 ,  rcx
+        pop rbp
+        ret
+
+func_147:
+        push rbp
+        mov rbp,rsp
+	imul qword [rbp-104], rdi
+  and   r8 ,  rbx
+	and qword [rbp-120], r10 ; step 2
+	lea r9, [rdx+160]
+  imul   r9 ,  rax
+	lea r10, [r8+0]
+  and   rdi ,  rbx
+	test r11,1969
+	cmp r10,3545
+  sub   rcx ,  r8
+  imul   r8 ,  r11
+        pop rbp
+        ret
+
+func_148:
+        push rbp
+        mov rbp,rsp
+  or   rcx ,  rdx
+  cmp   rcx ,  rax ; step 1
+  mov   rdx ,  rax
+  xor   rdi ,  r11
+	test rdi,79
+  imul   rax ,  rsi
+  mov   r9 ,  rcx
+  sub   rcx ,  rdi
+	lea r9, [r11+32]
+	xor qword [rbp-24], rsi
+
+	and r8,3581
+.l10:	jnz .l10
+	add qword [rbp-40], rdi
+  add   r9 ,  rax
+  test   r9 ,  r11 ; step 13
+.l13:	jnz .l13
+        pop rbp
+        ret
+
+func_149:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  r10
+	test qword [rbp-120], rcx
+	lea rbx, [r8+424]
+  imul   rbx ,  rdi
+	lea r9, [rsi+424]
+	cmp r9,2982
+  imul   r10 ,  rsi ; step 6
+	lea r8, [rdx+256] ; step 7
+.l7:	jnz .l7
+        pop rbp
+        ret
+
+func_150:
+        push rbp
+        mov rbp,rsp
+  cmp   rsi ,  rdi ; step 0
+  sub   rax ,  r8
+  add   rdi ,  r11
+	lea r9, [r8+8]
+  or   rdi ,  rsi ; step 4
+	add rdx,3327
+  sub   rbx ,  rax
+	mov qword [rbp-32], r9
+.l7:	jnz .l7
+  test   r10 ,  rdx
+
+  sub   rcx ,  rdi
+.l9:	jnz .l9
+  test   rax ,  r10
+  or   rcx ,  r10
+  or   r10 ,  rax ; step 12
+  cmp   rsi ,  r8
+	cmp r8,3529
+        pop rbp
+        ret
+
+func_151:
+        push rbp
+        mov rbp,rsp
+  and   rcx ,  rdi ; step 0
+	or rsi,3235
+	lea rbx, [rax+384]
+  xor   rbx ,  rax
+	and rdx,2672
+	and qword [rbp-64], r11 ; step 5
+  or   r9 ,  rdi ; step 6
+	cmp qword [rbp-104], rbx
+  cmp   rsi ,  r8
+.l8:	jnz .l8
+	and qword [rbp-128], rdi
+	imul r11,676 ; step 10
+.l10:	jnz .l10
+  cmp   r9 ,  r10
+  cmp   r10 ,  rdx
+  xor   rsi ,  rbx
+  imul   rax ,  rsi
+	sub qword [rbp-64], rax
+  sub   rdi ,  rdx
+.l16:	jnz .l16
+	lea rsi, [r8+272]
+  cmp   rbx ,  r8
+	imul rdi,3257
+        pop rbp
+        ret
+
+func_152:
+        push rbp
+        mov rbp,rsp
+	add qword [rbp-72], rcx
+  cmp   rax ,  r8
+	and qword [rbp-56], rsi ; step 2
+  xor   rbx ,  rcx
+  add   rax ,  rsi
+  cmp   rdi ,  rcx
+  add   rax ,  rdx ; step 6
+.l6:	jnz .l6
+  cmp   rdi ,  rcx
+  or   r8 ,  r11
+        pop rbp
+        ret
+
+func_153:
+        push rbp
+        mov rbp,rsp
+  mov   r8 ,  rcx
+  sub   rax ,  rdx
+  imul   r10 ,  r9 ; step 2
+  test   rsi ,  r10 ; step 3
+.l3:	jnz .l3
+  mov   r9 ,  r10
+	and qword [rbp-64], rdx
+  imul   r10 ,  rbx ; step 6
+	and rax,2864 ; step 7
+  and   r8 ,  rax ; step 8
+	and r10,3062
+	test rcx,2558
+        pop rbp
+        ret
+
+func_154:
+        push rbp
+        mov rbp,rsp
+	add rax,3956 ; step 0
+.l0:	jnz .l0
+	add rbx,633
+.l1:	jnz .l1
+  cmp   r11 ,  rdx
+.l2:	jnz .l2
+	xor rdx,2946 ; step 3
+  test   r10 ,  rsi
+  imul   rdi ,  r8
+.l5:	jnz .l5
+  mov   rax ,  r11
+  cmp   r9 ,  rbx
+        pop rbp
+        ret
+
+func_155:
+        push rbp
+        mov rbp,rsp
+  cmp   rdx ,  r9 ; step 0
+	lea rbx, [r9+280]
+
+	test r9,156
+  or   rdi ,  rcx
+
+  mov   rax ,  rbx
+
+	or qword [rbp-112], rcx
+.l5:	jnz .l5
+  cmp   r11 ,  r8
+	lea r10, [r9+8] ; step 7
+  xor   r9 ,  r10
+  sub   r9 ,  rcx
+        pop rbp
+        ret
+
+func_156:
+        push rbp
+        mov rbp,rsp
+  and   rbx ,  r11
+	mov qword [rbp-72], rcx ; step 1
+	imul rdx,2697
+
+	imul rbx,704
+  mov   rbx ,  r9 ; step 4
+	lea r8, [r10+400]
+.l5:	jnz .l5
+	cmp rdi,977
+.l6:	jnz .l6
+  add   rax ,  r8 ; step 7
+
+	lea rdx, [rbx+192]
+	or rax,3183
+	lea rbx, [r9+280]
+	imul rax,3033 ; step 11
+	mov qword [rbp-8], rdi
+        pop rbp
+        ret
+
+func_157:
+        push rbp
+        mov rbp,rsp
+  and   rax ,  rsi ; step 0
+.l0:	jnz .l0
+	xor qword [rbp-16], rax
+  test   rsi ,  rcx
+  add   rcx ,  rdx ; step 3
+	add rax,927
+	add rdi,2045
+	xor qword [rbp-64], r9 ; step 6
+  xor   r9 ,  rbx
+	sub qword [rbp-128], rcx ; step 8
+
+        pop rbp
+        ret
+
+func_158:
+        push rbp
+        mov rbp,rsp
+	lea rcx, [rbx+96] ; step 0
+  add   r11 ,  rax
+	or qword [rbp-120], r11
+.l2:	jnz .l2
+	and r8,804
+  add   r11 ,  rsi ; step 4
+  test   r9 ,  r11
+	and rax,595
+  or   rdx ,  rcx
+  cmp   r11 ,  r8
+  add   r10 ,  rbx
+  and   rbx ,  rsi
+  xor   r11 ,  r9
+	imul rbx,3453
+.l12:	jnz .l12
+  imul   rsi ,  rdi
+	lea r10, [rdi+32]
+	sub qword [rbp-104], rsi
+  and   rdx ,  rbx
+  xor   r11 ,  r9
+	and r8,704
+	lea r9, [r8+96]
+        pop rbp
+        ret
+
+func_159:
+        push rbp
+        mov rbp,rsp
+  test   r11 ,  r9 ; step 0
+.l0:	jnz .l0
+  test   r11 ,  rsi
+	xor rdx,3846
+
+	and qword [rbp-120], r8 ; step 3
+  and   r8 ,  rdx ; step 4
+  imul   r8 ,  rbx
+  test   r11 ,  rbx ; step 6
+  imul   rsi ,  r9
+	or r11,1773
+  imul   rax ,  rdx
+.l9:	jnz .l9
+	sub qword [rbp-48], r9
+  mov   r10 ,  rax ; step 11
+
+	add rdx,3086
+  cmp   rdi ,  r10
+        pop rbp
+        ret
+
+func_160:
+        push rbp
+        mov rbp,rsp
+  add   rcx ,  r9
+	test qword [rbp-32], rdi
+.l1:	jnz .l1
+  xor   rdx ,  r8
+  xor   r9 ,  rax
+	imul qword [rbp-88], rdi
+  sub   r11 ,  rax
+  add   r11 ,  rax
+  mov   r8 ,  r11 ; step 7
+	sub r8,1623
+	lea rdx, [r9+296] ; step 9
+  sub   r8 ,  r9
+  add   rsi ,  r11
+        pop rbp
+        ret
+
+func_161:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  rax
+  add   rdi ,  r10
+
+	and rdx,3476
+  mov   rbx ,  rdx
+.l3:	jnz .l3
+	xor rbx,3719
+
+	imul r10,203 ; step 5
+	or rsi,928
+	and rax,1753 ; step 7
+  and   rcx ,  rax
+  cmp   r11 ,  r9
+.l9:	jnz .l9
+  cmp   r10 ,  rdx
+.l10:	jnz .l10
+	cmp r9,3529 ; step 11
+.l11:	jnz .l11
+        pop rbp
+        ret
+
+func_162:
+        push rbp
+        mov rbp,rsp
+  cmp   rcx ,  r11
+  or   rdi ,  r9 ; step 1
+  xor   rdx ,  r10
+  sub   r9 ,  r10
+	sub rcx,1942
+.l4:	jnz .l4
+	xor qword [rbp-128], rcx
+  add   rbx ,  rcx
+.l6:	jnz .l6
+	lea rbx, [rdi+512]
+  cmp   r10 ,  r11
+	lea rdx, [rbx+240]
+	add rdx,2462
+        pop rbp
+        ret
+
+func_163:
+        push rbp
+        mov rbp,rsp
+  mov   rbx ,  rsi ; step 0
+	or qword [rbp-56], r11 ; step 1
+	imul qword [rbp-96], rax
+	test rdi,29
+  imul   r11 ,  r9
+	lea r11, [rcx+216]
+	or rdi,3447
+  xor   r8 ,  rbx ; step 7
+  and   rax ,  r9
+  add   rdi ,  r9 ; step 9
+  sub   rbx ,  rsi
+	or r11,2886
+	imul qword [rbp-128], rbx
+  cmp   rdx ,  rsi
+	add qword [rbp-40], r11
+  sub   rdi ,  rbx
+  or   rdi ,  rsi ; step 16
+  and   rcx ,  rdx
+.l17:	jnz .l17
+        pop rbp
+        ret
+
+func_164:
+        push rbp
+        mov rbp,rsp
+	cmp rsi,1913
+	lea rdx, [r11+488]
+  add   rsi ,  r10 ; step 2
+  or   rbx ,  r9
+.l3:	jnz .l3
+	test rbx,3818 ; step 4
+	lea rax, [rsi+208] ; step 5
+	test r9,456
+	imul rbx,674
+.l7:	jnz .l7
+  test   r8 ,  rsi
+  test   r9 ,  rbx
+.l9:	jnz .l9
+  or   rbx ,  rdi ; step 10
+	and rbx,3891
+	sub rax,3849
+  test   rcx ,  r11
+        pop rbp
+        ret
+
+func_165:
+        push rbp
+        mov rbp,rsp
+	lea r10, [r8+200] ; step 0
+  and   r9 ,  rsi
+  or   r9 ,  r11
+  xor   rdx ,  rcx
+	lea r11, [rax+224]
+	lea r9, [rsi+216]
+  cmp   rsi ,  r11
+	sub qword [rbp-72], rdi ; step 7
+  add   r11 ,  r9
+  or   rdi ,  rax
+  xor   rdi ,  r9 ; step 10
+  sub   rcx ,  rdi
+  cmp   r8 ,  r9
+	lea rdi, [r11+224]
+.l13:	jnz .l13
+  mov   r11 ,  r10 ; step 14
+  imul   rax ,  rcx
+.l15:	jnz .l15
+  sub   r10 ,  r9
+  and   rdi ,  r10 ; step 17
+.l17:	jnz .l17
+  cmp   r10 ,  r8
+        pop rbp
+        ret
+
+func_166:
+        push rbp
+        mov rbp,rsp
+  add   rax ,  rsi
+  add   r10 ,  rcx
+  or   rdx ,  rsi ; step 2
+  or   rbx ,  r10
+	lea r10, [r11+112]
+
+	or r10,2219
+	imul r10,106 ; step 6
+  imul   rdx ,  rdi ; step 7
+.l7:	jnz .l7
+	lea r8, [rcx+472]
+
+	test rdx,3307 ; step 9
+.l9:	jnz .l9
+	lea r9, [r11+96] ; step 10
+.l10:	jnz .l10
+  test   rdi ,  rcx ; step 11
+  add   r11 ,  r10
+	add qword [rbp-48], r10
+	lea r9, [rdx+184] ; step 14
+        pop rbp
+        ret
+
+func_167:
+        push rbp
+        mov rbp,rsp
+	cmp qword [rbp-16], r10 ; step 0
+	test r10,3380 ; step 1
+  imul   r8 ,  r11
+
+  sub   r9 ,  r11 ; step 3
+	test rbx,1111
+
+  test   r9 ,  rsi
+  xor   rdx ,  r10
+	mov qword [rbp-8], rsi
+  test   r11 ,  rbx ; step 8
+	add qword [rbp-72], rbx
+  and   rdi ,  rbx
+
+  test   rbx ,  r9 ; step 11
+  xor   rdi ,  rdx ; step 12
+  or   rcx ,  r8
+	or r10,779 ; step 14
+
+        pop rbp
+        ret
+
+func_168:
+        push rbp
+        mov rbp,rsp
+  xor   r10 ,  r9 ; step 0
+  add   rdi ,  rdx
+  xor   rdx ,  r9
+
+	sub rdi,3052
+  add   rcx ,  r10
+	imul rax,1946
+  test   rsi ,  rbx
+  imul   r8 ,  r9
+	add r8,899
+.l8:	jnz .l8
+  test   rcx ,  r8
+  imul   r10 ,  rsi ; step 10
+	cmp rdi,327
+  xor   r10 ,  r11 ; step 12
+.l12:	jnz .l12
+  cmp   r10 ,  rbx ; step 13
+	add r9,1864
+.l14:	jnz .l14
+	lea rdi, [r8+368]
+        pop rbp
+        ret
+
+func_169:
+        push rbp
+        mov rbp,rsp
+  test   rdx ,  rsi ; step 0
+
+  and   r11 ,  r9 ; step 1
+  mov   rsi ,  rdx ; step 2
+	and rax,1652
+.l3:	jnz .l3
+	lea r8, [rax+88]
+	lea rax, [r8+480] ; step 5
+  and   r9 ,  r8
+  sub   r11 ,  rax ; step 7
+  mov   rsi ,  rbx ; step 8
+	lea r10, [r11+72] ; step 9
+	lea rsi, [r11+416]
+  or   rax ,  rbx ; step 11
+.l11:	jnz .l11
+	test r9,3072
+  imul   rax ,  r8
+  xor   r8 ,  rsi
+	add qword [rbp-72], rcx
+        pop rbp
+        ret
+
+func_170:
+        push rbp
+        mov rbp,rsp
+	sub qword [rbp-120], r9
+	or rax,1308
+  and   rdi ,  rbx
+	xor qword [rbp-80], rax ; step 3
+  xor   rsi ,  r11
+  or   rdi ,  r9
+.l5:	jnz .l5
+  mov   r9 ,  rax
+  or   rcx ,  rdi
+	and qword [rbp-72], r11 ; step 8
+  xor   r9 ,  rax
+  cmp   rdx ,  rbx
+.l10:	jnz .l10
+	sub qword [rbp-16], r9
+.l11:	jnz .l11
+	or rax,1134
+
+  or   rdi ,  rbx
+  or   r8 ,  rdx
+	add r8,504 ; step 15
+	and r8,1745
+  sub   r10 ,  rcx
+  and   rax ,  r10
+  and   rax ,  r8
+        pop rbp
+        ret
+
+func_171:
+        push rbp
+        mov rbp,rsp
+  and   rsi ,  rbx
+	sub qword [rbp-48], rsi
+  and   rcx ,  rsi
+	and rsi,3435
+
+	lea rcx, [r10+416] ; step 4
+  and   r11 ,  rdi
+	xor qword [rbp-128], rax
+	and r9,852
+  test   r8 ,  r11
+  and   rbx ,  rax ; step 9
+        pop rbp
+        ret
+
+func_172:
+        push rbp
+        mov rbp,rsp
+	or rax,378 ; step 0
+  add   r11 ,  rsi
+	cmp qword [rbp-104], r9
+  imul   rdi ,  rbx
+	imul qword [rbp-24], rsi
+	add r10,2991
+  add   rsi ,  rdi
+	sub r9,1238
+  cmp   r9 ,  rbx
+  and   r8 ,  rbx
+  add   rsi ,  rcx
+        pop rbp
+        ret
+
+func_173:
+        push rbp
+        mov rbp,rsp
+	and rbx,3764 ; step 0
+  add   r9 ,  rsi
+	cmp qword [rbp-16], r9
+  imul   r10 ,  r11 ; step 3
+	lea rdi, [r8+368] ; step 4
+
+	lea rdx, [r9+424] ; step 5
+	imul r10,600
+  add   rax ,  r9
+	and rdx,2349
+  test   rsi ,  rbx
+	sub rdx,1058
+	cmp qword [rbp-56], r11
+	lea rdi, [rbx+264] ; step 12
+  xor   r9 ,  rax ; step 13
+	add rcx,2399
+        pop rbp
+        ret
+
+func_174:
+        push rbp
+        mov rbp,rsp
+	and rbx,885
+	lea rsi, [rbx+464]
+	imul qword [rbp-96], r11
+  imul   r10 ,  r8
+	lea rcx, [rax+304] ; step 4
+  mov   rdi ,  rcx
+  and   rcx ,  rbx
+	sub r8,3589 ; step 7
+
+  add   rax ,  r11
+  add   r11 ,  r10
+	mov r11,730
+  sub   r11 ,  rbx
+  mov   r10 ,  rax
+  mov   rcx ,  rax
+.l13:	jnz .l13
+	imul qword [rbp-112], r11 ; step 14
+  sub   rax ,  r8
+	lea rdx, [r10+464]
+  or   rdi ,  rax
+  xor   r9 ,  rbx ; step 18
+        pop rbp
+        ret
+
+func_175:
+        push rbp
+        mov rbp,rsp
+  mov   rdi ,  r10
+	mov qword [rbp-16], rdi
+  and   rax ,  rsi
+	test qword [rbp-72], rax
+  cmp   r9 ,  rcx
+	sub qword [rbp-48], r8
+	cmp r11,3004
+	cmp qword [rbp-24], rdx ; step 7
+  and   rdx ,  rax
+.l8:	jnz .l8
+
+  cmp   r9 ,  r10
+	imul r10,554
+  add   r9 ,  r8
+	sub qword [rbp-48], rsi
+  test   rsi ,  r10
+  sub   r9 ,  rbx
+	mov rdi,700 ; step 15
+  sub   rdx ,  rbx ; step 16
+
+	lea rbx, [r11+272] ; step 17
+  and   rdi ,  r11
+        pop rbp
+        ret
+
+func_176:
+        push rbp
+        mov rbp,rsp
+  sub   r10 ,  rax
+  imul   rax ,  r8
+	cmp rax,378
+
+  test   r9 ,  r10 ; step 3
+  mov   rdi ,  r10
+	xor r9,778 ; step 5
+  imul   r11 ,  rbx
+	mov qword [rbp-112], r11 ; step 7
+	lea r8, [rax+256] ; step 8
+  or   r9 ,  r11
+  cmp   rbx ,  r8 ; step 10
+	mov rbx,2429
+  mov   r10 ,  rbx ; step 12
+  mov   rax ,  rbx
+	lea r10, [r8+400]
+	add rdi,2219 ; step 15
+        pop rbp
+        ret
+
+func_177:
+        push rbp
+        mov rbp,rsp
+  cmp   rbx ,  r10 ; step 0
+
+	add qword [rbp-8], r11
+
+	imul qword [rbp-96], rsi
+  xor   r8 ,  rdx
+.l3:	jnz .l3
+  add   r9 ,  rcx
+  or   r9 ,  rdx
+  cmp   r8 ,  rdx ; step 6
+  test   rsi ,  r8
+.l7:	jnz .l7
+  mov   r8 ,  rdi
+  test   rbx ,  r8
+	lea r8, [rsi+368]
+  mov   rdi ,  r8
+	lea rdx, [r11+352]
+	or qword [rbp-32], rsi ; step 13
+.l13:	jnz .l13
+  cmp   rdi ,  r8
+.l14:	jnz .l14
+  test   rax ,  rbx ; step 15
+  cmp   rdi ,  r9
+        pop rbp
+        ret
+
+func_178:
+        push rbp
+        mov rbp,rsp
+	cmp qword [rbp-40], rax ; step 0
+	and rax,3162
+  sub   rax ,  rcx ; step 2
+.l2:	jnz .l2
+  cmp   r10 ,  r11
+	or qword [rbp-56], r9
+.l4:	jnz .l4
+	mov qword [rbp-88], r8
+  xor   rdi ,  rbx
+  and   rbx ,  rdi
+  imul   r11 ,  rdx
+  cmp   rdx ,  r9
+	mov qword [rbp-24], r11
+	imul qword [rbp-72], r9 ; step 11
+	sub r8,789
+        pop rbp
+        ret
+
+func_179:
+        push rbp
+        mov rbp,rsp
+  mov   rdx ,  rbx ; step 0
+	add qword [rbp-48], r10 ; step 1
+  cmp   rbx ,  rdi
+	xor qword [rbp-96], r10
+  imul   rsi ,  rcx
+  xor   rbx ,  rsi
+  cmp   rdx ,  rcx
+  mov   r8 ,  r11 ; step 7
+  imul   r9 ,  rdi
+  xor   rbx ,  r9
+	sub rax,2351
+  sub   r8 ,  rsi
+  imul   r9 ,  rsi ; step 12
+	lea r11, [rsi+0]
+  xor   rdi ,  r11
+	cmp qword [rbp-48], r10
+        pop rbp
+        ret
+
+func_180:
+        push rbp
+        mov rbp,rsp
+  and   rsi ,  r8
+	xor rax,195 ; step 1
+  imul   r9 ,  rax
+	add qword [rbp-72], r9 ; step 3
+  imul   r10 ,  rsi ; step 4
+	imul qword [rbp-80], rax ; step 5
+  imul   r9 ,  r11
+	add qword [rbp-104], rsi ; step 7
+        pop rbp
+        ret
+
+func_181:
+        push rbp
+        mov rbp,rsp
+  test   r11 ,  rsi
+  or   rdi ,  rsi ; step 1
+	cmp rbx,2802
+  and   r11 ,  rdx ; step 3
+  or   r9 ,  r10
+	or r10,3186
+  or   rdx ,  rdi
+	lea rsi, [rdx+408]
+	lea r10, [rcx+432]
+  or   rbx ,  r9 ; step 9
+	sub r10,2419
+        pop rbp
+        ret
+
+func_182:
+        push rbp
+        mov rbp,rsp
+  mov   rcx ,  rbx ; step 0
+	imul r8,2403
+
+	lea r11, [rcx+128]
+	lea r10, [r9+112]
+	test rsi,1358
+  add   r10 ,  r11 ; step 5
+  xor   r8 ,  rcx
+	lea r8, [rax+312]
+  mov   r10 ,  rcx
+	test r10,3731
+  imul   rbx ,  r11 ; step 10
+
+        pop rbp
+        ret
+
+func_183:
+        push rbp
+        mov rbp,rsp
+  mov   rax ,  r10 ; step 0
+	lea rcx, [rsi+320]
+.l1:	jnz .l1
+  cmp   rsi ,  r9
+  or   r10 ,  rcx ; step 3
+  or   rbx ,  rax
+  test   rdi ,  rsi
+.l5:	jnz .l5
+	lea rax, [rbx+456]
+  or   r10 ,  r8
+	lea rcx, [rax+232]
+        pop rbp
+        ret
+
+func_184:
+        push rbp
+        mov rbp,rsp
+	mov qword [rbp-112], rcx
+  cmp   r8 ,  r11
+  xor   rdx ,  r10
+  mov   rbx ,  r10 ; step 3
+	imul rdi,1651
+  or   rbx ,  rdx
+.l5:	jnz .l5
+	cmp rsi,3122
+	or r9,120 ; step 7
+	lea rdi, [rsi+440]
+  or   r10 ,  r9
+	test qword [rbp-120], r10
+  and   rsi ,  r9
+	sub r8,3479 ; step 12
+.l12:	jnz .l12
+  and   rbx ,  r10 ; step 13
+  sub   r9 ,  rsi ; step 14
+	or rcx,281 ; step 15
+
+	test r8,1870
+  test   rsi ,  rbx
+        pop rbp
+        ret
+
+func_185:
+        push rbp
+        mov rbp,rsp
+	add qword [rbp-120], r11
+.l0:	jnz .l0
+	test rdx,4006
+  and   r8 ,  rcx ; step 2
+  and   rax ,  rsi
+
+  mov   r11 ,  r9
+  add   r11 ,  r8 ; step 5
+  sub   rdx ,  rdi ; step 6
+  test   rbx ,  r9
+	sub rsi,2974
+  mov   r11 ,  r9
+  cmp   r10 ,  rdi
+  or   r9 ,  r8
+  and   rsi ,  rcx ; step 12
+  mov   rsi ,  rbx
+  and   r10 ,  r9
+.l14:	jnz .l14
+	add qword [rbp-88], rdi
+.l15:	jnz .l15
+	test r9,1210
+  cmp   rcx ,  r10
+        pop rbp
+        ret
+
+func_186:
+        push rbp
+        mov rbp,rsp
+  mov   r10 ,  rax
+  or   r9 ,  r11
+  or   r11 ,  rdi
+  mov   r10 ,  r9
+	lea rcx, [rax+160]
+  or   r11 ,  rcx
+.l5:	jnz .l5
+	xor qword [rbp-64], r11
+  add   rcx ,  rsi
+	or rdx,1822
+  xor   rax ,  r10
+  xor   rbx ,  r9
+  or   rcx ,  rsi
+  sub   rdx ,  rax ; step 12
+	sub r10,951
+        pop rbp
+        ret
+
+func_187:
+        push rbp
+        mov rbp,rsp
+  cmp   rax ,  rdi ; step 0
+	and qword [rbp-48], r10 ; step 1
+  cmp   rsi ,  rbx
+
+  xor   rax ,  rcx
+  imul   r9 ,  r8 ; step 4
+  add   rbx ,  rdx ; step 5
+  test   rbx ,  rcx ; step 6
+	imul rdi,2683
+
+	sub qword [rbp-80], rsi
+  test   rbx ,  r9 ; step 9
+  or   rdi ,  rcx
+  cmp   rcx ,  r10
+  xor   r8 ,  rax
+  add   rdx ,  rdi
+  mov   r9 ,  rsi ; step 14
+  test   rdx ,  rsi ; step 15
+        pop rbp
+        ret
+
+func_188:
+        push rbp
+        mov rbp,rsp
+	lea r9, [rbx+328]
+	sub qword [rbp-56], rdi
+	xor r10,999
+  or   rsi ,  rdx
+.l3:	jnz .l3
+
+  mov   rdx ,  rbx ; step 4
+	mov qword [rbp-56], rax
+	sub r10,3498
+	add qword [rbp-64], rbx
+  cmp   rdx ,  r9
+        pop rbp
+        ret
+
+func_189:
+        push rbp
+        mov rbp,rsp
+  imul   rcx ,  r8
+	mov qword [rbp-24], r8 ; step 1
+	mov rdx,2645
+	mov qword [rbp-72], r8 ; step 3
+  and   r8 ,  r11
+	cmp rcx,260
+  test   rbx ,  r9 ; step 6
+  mov   rax ,  rcx ; step 7
+	and qword [rbp-56], r11
+  mov   r10 ,  rcx
+  mov   rbx ,  r10
+
+  mov   r8 ,  r9
+        pop rbp
+        ret
+
+func_190:
+        push rbp
+        mov rbp,rsp
+	lea rdi, [r11+288]
+  or   rbx ,  rdi
+	test rdi,2723
+	or qword [rbp-24], rdx
+  sub   r11 ,  rbx ; step 4
+  mov   rsi ,  r9
+.l5:	jnz .l5
+	lea r9, [r8+408] ; step 6
+  cmp   rdx ,  rsi ; step 7
+	lea rdx, [rsi+272]
+  cmp   rsi ,  rdi
+	xor qword [rbp-96], r10
+  sub   rax ,  rdi ; step 11
+  test   rsi ,  rax
+        pop rbp
+        ret
+
+func_191:
+        push rbp
+        mov rbp,rsp
+  and   r10 ,  rdx ; step 0
+.l0:	jnz .l0
+  imul   r10 ,  rbx
+
+	lea r11, [rbx+64]
+  imul   r11 ,  rdx ; step 3
+  or   rdx ,  r10 ; step 4
+	add rbx,3979 ; step 5
+.l5:	jnz .l5
+  mov   r11 ,  rdx ; step 6
+	mov qword [rbp-80], rdx
+	or qword [rbp-8], rdx
+  add   r10 ,  rsi
+  test   r11 ,  rdi
+        pop rbp
+        ret
+
+func_192:
+        push rbp
+        mov rbp,rsp
+  and   rsi ,  rcx
+	imul rbx,2272
+	imul r9,2699
+.l2:	jnz .l2
+  add   r8 ,  rax
+  add   rdx ,  rbx
+
+  add   r9 ,  rbx ; step 5
+  or   r11 ,  r8
+	add r10,2477 ; step 7
+  xor   rsi ,  rcx
+
+  or   rdi ,  rcx
+        pop rbp
+        ret
+
+func_193:
+        push rbp
+        mov rbp,rsp
+	test qword [rbp-72], r9
+	lea r10, [rax+352] ; step 1
+.l1:	jnz .l1
+  mov   rdx ,  rbx
+  and   rsi ,  rdi
+  or   rcx ,  rbx
+  xor   rcx ,  rax
+  imul   rdi ,  r10
+  mov   rdx ,  r9 ; step 7
+  sub   rdi ,  r9 ; step 8
+	imul r10,2071
+.l9:	jnz .l9
+
+	mov r11,1897
+	add rax,1346
+        pop rbp
+        ret
+
+func_194:
+        push rbp
+        mov rbp,rsp
+  imul   rdx ,  rax
+	and qword [rbp-32], r9
+	sub qword [rbp-64], rdi
+	imul r11,1523
+.l3:	jnz .l3
+	lea rsi, [r10+336]
+  xor   rdi ,  rsi ; step 5
+  test   r10 ,  r9
+	lea rdi, [r8+32]
+  imul   rcx ,  rdx
+	and qword [rbp-16], rbx
+  and   r9 ,  rsi
+  imul   rax ,  r10 ; step 11
+  mov   rdi ,  r11 ; step 12
+  test   r9 ,  rdi
+	cmp qword [rbp-40], r8
+.l14:	jnz .l14
+
+	add qword [rbp-64], r9
+  mov   r11 ,  rdx ; step 16
+.l16:	jnz .l16
+  imul   rcx ,  r8
+	imul qword [rbp-8], rbx
+        pop rbp
+        ret
+
+func_195:
+        push rbp
+        mov rbp,rsp
+  imul   rsi ,  rax
+  sub   r11 ,  rdx
+  mov   r10 ,  rdi ; step 2
+  mov   rsi ,  r9
+	or qword [rbp-56], rcx
+  or   rsi ,  rdi
+  imul   r10 ,  r8
+	mov qword [rbp-112], rdx
+  add   rax ,  rbx ; step 8
+  mov   rdx ,  rcx
+  add   rsi ,  rdi
+	xor qword [rbp-72], r9
+        pop rbp
+        ret
+
+func_196:
+        push rbp
+        mov rbp,rsp
+  add   r10 ,  rdx
+  mov   rdi ,  r10 ; step 1
+  mov   rbx ,  rdx
+  imul   r10 ,  r9
+  add   rbx ,  r9
+  mov   r10 ,  rbx
+  add   rbx ,  rcx
+  sub   r11 ,  rax ; step 7
+	lea r9, [rdx+24]
+  and   r10 ,  r9
+	sub qword [rbp-8], rdx
+  xor   rcx ,  rdi ; step 11
+	and qword [rbp-128], rdx
+	mov qword [rbp-104], r11 ; step 13
+.l13:	jnz .l13
+  imul   r10 ,  r8 ; step 14
+
+  or   r11 ,  r8
+  and   r10 ,  rdi
+  or   rcx ,  r9
+	imul r9,3771
+  sub   r8 ,  rax
+        pop rbp
+        ret
+
+func_197:
+        push rbp
+        mov rbp,rsp
+  or   rdx ,  rcx
+
+  imul   rdx ,  rbx
+.l1:	jnz .l1
+  xor   rax ,  rdi
+  xor   r9 ,  r11 ; step 3
+	or rdx,38 ; step 4
+  and   r8 ,  rcx
+  add   r11 ,  rsi
+  and   rbx ,  rdx
+  test   r11 ,  rbx
+.l8:	jnz .l8
+  cmp   r10 ,  rdx
+        pop rbp
+        ret
+
+func_198:
+        push rbp
+        mov rbp,rsp
+	sub qword [rbp-32], rbx ; step 0
+  or   rdx ,  r8
+	mov r9,3348
+  xor   rdi ,  r10
+	lea r11, [r8+0]
+.l4:	jnz .l4
+	xor qword [rbp-16], rcx ; step 5
+	lea rax, [rdi+72]
+	lea rbx, [r9+184]
+	lea rdx, [r11+152]
+	add r9,1154
+  test   r8 ,  rbx
+.l10:	jnz .l10
+	add r8,1383
+  sub   rdx ,  r8
+	test rax,1766
+  test   rdx ,  rax ; step 14
+
+        pop rbp
+        ret
+
+func_199:
+        push rbp
+        mov rbp,rsp
+	cmp qword [rbp-32], rbx ; step 0
+  add   r11 ,  rdi
+.l1:	jnz .l1
+  or   rdx ,  rax
+  or   r11 ,  r9 ; step 3
+.l3:	jnz .l3
+	imul rcx,1661
+  cmp   rax ,  rsi
+  add   r10 ,  rdi ; step 6
+  sub   rax ,  rdi
+        pop rbp
+        ret
+
+func_200:
+        push rbp
+        mov rbp,rsp
+  cmp   rsi ,  rax ; step 0
+.l0:	jnz .l0
+  or   r10 ,  rdx ; step 1
+  cmp   rdi ,  r10 ; step 2
+	cmp r11,2994
+	lea rsi, [r8+152] ; step 4
+	add rbx,2288
+	add r8,2488 ; step 6
+.l6:	jnz .l6
+  mov   rcx ,  r10
+  add   r11 ,  r10
+  imul   rdx ,  r10 ; step 9
+.l9:	jnz .l9
+	and qword [rbp-88], rbx ; step 10
+  imul   r8 ,  rdi
+	add rdi,1611
+	test rdx,2546 ; step 13
+.l13:	jnz .l13
+	xor rsi,1864
+  mov   r9 ,  rsi
+.l15:	jnz .l15
+
+        pop rbp
+        ret
+
+func_201:
+        push rbp
+        mov rbp,rsp
+	mov r8,3551 ; step 0
+  add   rdi ,  rbx
+
+	sub rcx,1517
+  and   r8 ,  rsi
+  test   rsi ,  r8 ; step 4
+
+  cmp   rsi ,  rbx
+  mov   r10 ,  r11 ; step 6
+.l6:	jnz .l6
+  xor   r8 ,  r9
+	lea rcx, [r10+208] ; step 8
+  xor   rsi ,  r9
+  and   rax ,  r8
+	lea rdi, [r10+424]
+  mov   r9 ,  r8 ; step 12
+	lea rsi, [r10+272]
+  sub   r9 ,  rdx
+        pop rbp
+        ret
+
+func_202:
+        push rbp
+        mov rbp,rsp
+  imul   r8 ,  rcx
+  and   r11 ,  r8
+  cmp   rdx ,  rcx
+.l2:	jnz .l2
+  add   rdi ,  r8
+  or   rcx ,  r11
+  xor   r8 ,  rbx ; step 5
+	sub qword [rbp-24], r10 ; step 6
+  imul   r11 ,  r10
+  sub   rax ,  r10
+
+	lea rax, [rbx+320]
+	lea rbx, [rsi+216]
+
+        pop rbp
+        ret
+
+func_203:
+        push rbp
+        mov rbp,rsp
+  or   rsi ,  rdx
+	imul qword [rbp-16], rax
+	and r9,3081
+
+	or rbx,1409 ; step 3
+  add   r10 ,  r9
+	lea r11, [rax+152] ; step 5
+  xor   rax ,  r9
+	sub r9,2357
+        pop rbp
+        ret
+
+func_204:
+        push rbp
+        mov rbp,rsp
+  mov   rdi ,  rsi ; step 0
+  xor   r8 ,  rcx ; step 1
+
+  add   r8 ,  rdx
+.l2:	jnz .l2
+	or qword [rbp-40], rax ; step 3
+  add   rdi ,  rcx ; step 4
+	lea rcx, [rsi+424]
+  sub   rdi ,  r11
+  or   rbx ,  rcx ; step 7
+.l7:	jnz .l7
+	mov rax,4018
+	imul r8,1425
+	cmp r9,1621
+  and   rcx ,  r8
+	lea r11, [rdx+216] ; step 12
+	xor r11,3119 ; step 13
+  cmp   r8 ,  r11
+  cmp   r9 ,  rcx
+  mov   r9 ,  rdi ; step 16
+  add   rax ,  r10 ; step 17
+.l17:	jnz .l17
+	cmp qword [rbp-16], rax
+        pop rbp
+        ret
+
+func_205:
+        push rbp
+        mov rbp,rsp
+  cmp   r8 ,  rdx
+  sub   rax ,  r9 ; step 1
+  cmp   r8 ,  rdi ; step 2
+  imul   r10 ,  rsi
+  imul   rcx ,  r10 ; step 4
+  add   rdx ,  rcx
+  test   rbx ,  r9 ; step 6
+  and   r10 ,  r8
+	lea r8, [rdx+368]
+  cmp   rcx ,  r9
+  imul   r9 ,  r10 ; step 10
+  xor   r9 ,  r11
+	imul r8,240
+	imul rcx,1435
+  imul   rsi ,  rbx ; step 14
+	cmp qword [rbp-104], rbx
+	imul r10,2207 ; step 16
+        pop rbp
+        ret
+
+func_206:
+        push rbp
+        mov rbp,rsp
+	and qword [rbp-120], rbx
+.l0:	jnz .l0
+	lea r11, [rcx+72] ; step 1
+	imul r10,1157
+	lea rdi, [r10+496] ; step 3
+  add   rdi ,  rax ; step 4
+.l4:	jnz .l4
+	and rcx,710
+
+  and   rdi ,  r11 ; step 6
+  add   rdx ,  rsi
+        pop rbp
+        ret
+
+func_207:
+        push rbp
+        mov rbp,rsp
+  or   rcx ,  r8
+	test rax,3432
+	and qword [rbp-64], rsi
+  sub   rax ,  r10
+
+	lea rcx, [rdi+408]
+  xor   r8 ,  rdx ; step 5
+	test r11,3820
+	or r11,2835 ; step 7
+
+	lea rsi, [rcx+88]
+        pop rbp
+        ret
+
+func_208:
+        push rbp
+        mov rbp,rsp
+  and   r8 ,  rax ; step 0
+  cmp   rdi ,  rbx
+
+  imul   rdx ,  rcx ; step 2
+	lea r10, [rsi+72] ; step 3
+.l3:	jnz .l3
+  cmp   rbx ,  r10
+
+	add qword [rbp-96], rdx
+.l5:	jnz .l5
+	or qword [rbp-96], rsi ; step 6
+.l6:	jnz .l6
+	mov qword [rbp-56], rdi
+	imul rdx,1663
+  xor   rcx ,  r8
+  xor   r10 ,  r11
+  sub   rdi ,  rdx
+	mov rbx,1034
+	and rdi,288
+  add   r10 ,  r9 ; step 14
+  or   r10 ,  rbx ; step 15
+  sub   r10 ,  r11 ; step 16
+  and   rax ,  rsi ; step 17
+
+	cmp rbx,162 ; step 18
+        pop rbp
+        ret
+
+func_209:
+        push rbp
+        mov rbp,rsp
+  test   rsi ,  rdi ; step 0
+	and rsi,2069 ; step 1
+
+	cmp rsi,2041
+.l2:	jnz .l2
+	cmp r11,618
+	cmp qword [rbp-72], rsi
+  sub   rcx ,  r9
+  add   rdi ,  rax ; step 6
+	cmp rcx,2535
+  test   r10 ,  r8
+.l8:	jnz .l8
+  and   rcx ,  rdx
+  mov   r10 ,  rbx
+  sub   rdx ,  r10 ; step 11
+
+        pop rbp
+        ret
+
+func_210:
+        push rbp
+        mov rbp,rsp
+  add   rax ,  rdx ; step 0
+  or   rdi ,  r8
+
+	lea rcx, [r8+408] ; step 2
+
+  add   rcx ,  rbx
+  sub   rcx ,  r11 ; step 4
+  and   r10 ,  r9 ; step 5
+  mov   rdi ,  r10 ; step 6
+  test   rcx ,  r10
+  imul   rdi ,  rdx
+  add   rbx ,  r10
+	cmp rcx,3748 ; step 10
+  sub   r8 ,  rdx ; step 11
+
+  mov   rbx ,  rax ; step 12
+        pop rbp
+        ret
+
+func_211:
+        push rbp
+        mov rbp,rsp
+	lea r10, [rax+368]
+	sub r11,3142
+  mov   r11 ,  rax
+	add qword [rbp-128], r9
+.l3:	jnz .l3
+	and rdx,1572 ; step 4
+  imul   rax ,  r10
+	and rdi,3941
+  sub   rsi ,  r8
+.l7:	jnz .l7
+  test   rdi ,  r9 ; step 8
+  mov   r9 ,  r8
+	lea rdi, [rcx+208]
+  imul   r9 ,  r10 ; step 11
+	imul qword [rbp-64], rdi
+	and rcx,1535
+	sub qword [rbp-24], r10 ; step 14
+.l14:	jnz .l14
+  mov   rbx ,  r11
+	and r11,501
+  test   rax ,  rdx
+        pop rbp
+        ret
+
+func_212:
+        push rbp
+        mov rbp,rsp
+  sub   rbx ,  r9
+	add rsi,916 ; step 1
+  add   r11 ,  rdx
+.l2:	jnz .l2
+	or qword [rbp-80], rbx ; step 3
+  or   r10 ,  rdi
+	lea rcx, [rdx+280]
+  sub   rsi ,  rdi
+	and r8,2044
+  or   rsi ,  rbx
+	add qword [rbp-120], r10
+  or   r8 ,  rdx
+  mov   rdi ,  rsi ; step 11
+	lea r8, [rdi+8]
+  cmp   rax ,  rsi
+        pop rbp
+        ret
+
+func_213:
+        push rbp
+        mov rbp,rsp
+  sub   rdi ,  r8
+	and r8,618
+  sub   rdi ,  rcx ; step 2
+
+  or   rsi ,  r11 ; step 3
+  or   r9 ,  rcx
+  imul   rax ,  rsi ; step 5
+  cmp   r11 ,  rax
+	lea r8, [r10+328]
+	lea r9, [rdi+432]
+  sub   rax ,  rcx
+  mov   rbx ,  rax
+  imul   rbx ,  rdi ; step 11
+.l11:	jnz .l11
+  sub   r10 ,  rcx
+.l12:	jnz .l12
+	xor rcx,1535
+  sub   r11 ,  r10
+.l14:	jnz .l14
+        pop rbp
+        ret
+
+func_214:
+        push rbp
+        mov rbp,rsp
+	imul qword [rbp-128], r10 ; step 0
+  or   r11 ,  r9
+.l1:	jnz .l1
+	add rax,2858
+.l2:	jnz .l2
+	sub rcx,1306
+  sub   rax ,  r8
+  add   rbx ,  rdx
+	test r9,2175
+	lea rdx, [r9+24] ; step 7
+
+  cmp   rbx ,  rdi
+  add   rbx ,  rdx
+  sub   r10 ,  r8 ; step 10
+        pop rbp
+        ret
+
+func_215:
+        push rbp
+        mov rbp,rsp
+  test   r9 ,  rdi
+  sub   rcx ,  r8
+	imul rdx,1748
+	lea rax, [r8+280]
+  cmp   rax ,  rcx
+
+  and   rdi ,  r10
+  mov   rbx ,  rcx ; step 6
+  add   rbx ,  r8
+  add   rbx ,  rsi
+  and   rcx ,  r8
+  test   rcx ,  rdx
+	add qword [rbp-96], rcx ; step 11
+  sub   rdx ,  r11 ; step 12
+        pop rbp
+        ret
+
+func_216:
+        push rbp
+        mov rbp,rsp
+	xor rsi,2314 ; step 0
+	imul r8,2296 ; step 1
+.l1:	jnz .l1
+  sub   rsi ,  rcx
+	lea r11, [rbx+480]
+  and   r8 ,  r9
+  and   r9 ,  r8
+  mov   r10 ,  r8
+	test qword [rbp-120], r9 ; step 7
+	mov qword [rbp-104], r8
+  and   r11 ,  r8
+  mov   rax ,  rbx ; step 10
+        pop rbp
+        ret
+
+func_217:
+        push rbp
+        mov rbp,rsp
+	lea rcx, [r10+424]
+	imul rsi,2669
+
+	and rcx,2068
+  sub   r8 ,  rbx
+  test   rdx ,  r8 ; step 4
+	mov qword [rbp-56], r8
+	mov r8,1307
+.l6:	jnz .l6
+  sub   r11 ,  rcx
+
+  or   r10 ,  r9
+	mov qword [rbp-48], rdx
+	lea r8, [rdx+336]
+  or   rcx ,  rdx
+        pop rbp
+        ret
+
+func_218:
+        push rbp
+        mov rbp,rsp
+  xor   rbx ,  rcx
+  test   r9 ,  r8 ; step 1
+	xor r8,2149
+.l2:	jnz .l2
+  sub   rax ,  rbx
+  add   rbx ,  rsi
+.l4:	jnz .l4
+  sub   rbx ,  r8
+  sub   rax ,  r11
+  and   rax ,  rbx
+  or   rdx ,  r10
+        pop rbp
+        ret
+
+func_219:
+        push rbp
+        mov rbp,rsp
+  test   rdx ,  r8 ; step 0
+	and qword [rbp-8], rbx ; step 1
+  and   rdx ,  r11
+  and   rcx ,  r8
+
+  and   r10 ,  r11
+  imul   r9 ,  rbx
+  sub   rdx ,  rbx
+
+	or rdx,1571
+.l7:	jnz .l7
+	xor qword [rbp-112], rdi
+  or   r8 ,  rcx
+  xor   rcx ,  rdi ; step 10
+	or rax,1793
+
+        pop rbp
+        ret
+
+func_220:
+        push rbp
+        mov rbp,rsp
+  sub   rsi ,  rax ; step 0
+	lea rax, [rdx+232]
+	lea rcx, [rsi+56]
+	lea rdx, [r10+328]
+  sub   r8 ,  rsi
+  and   r9 ,  rdi
+.l5:	jnz .l5
+  cmp   rsi ,  rbx
+  and   r10 ,  rdi
+.l7:	jnz .l7
+  add   rdi ,  r11
+  and   rsi ,  r9
+  mov   rcx ,  r11 ; step 10
+  sub   rcx ,  r10 ; step 11
+        pop rbp
+        ret
+
+func_221:
+        push rbp
+        mov rbp,rsp
+	xor qword [rbp-72], rdi
+	or qword [rbp-120], rcx ; step 1
+.l1:	jnz .l1
+
+  cmp   rsi ,  r11
+	cmp qword [rbp-120], rsi
+  and   rax ,  rdi ; step 4
+	or rdx,2906
+  imul   r9 ,  rdx
+	sub r9,1504
+	test rdx,2310
+  imul   rcx ,  r8
+	imul qword [rbp-80], rsi
+  cmp   rcx ,  rax ; step 11
+	mov r10,192
+	sub rbx,2325
+  xor   rax ,  rsi
+  cmp   rcx ,  rax
+  test   rax ,  r11
+        pop rbp
+        ret
+
+func_222:
+        push rbp
+        mov rbp,rsp
+  mov   rsi ,  rbx
+.l0:	jnz .l0
+  and   rcx ,  rdx ; step 1
+	test rax,2656
+  add   rdi ,  rcx
+  mov   rax ,  rbx
+  add   r11 ,  rax
+.l5:	jnz .l5
+
+  and   r11 ,  r10
+  imul   r11 ,  r10
+  mov   rsi ,  r11
+  or   rdx ,  rbx
+  and   r10 ,  rsi ; step 10
+	add qword [rbp-80], rdx ; step 11
+	cmp rdx,4051
+	cmp rdi,2403
+.l13:	jnz .l13
+        pop rbp
+        ret
+
+func_223:
+        push rbp
+        mov rbp,rsp
+  sub   r8 ,  rdi
+  xor   rsi ,  r10 ; step 1
+  or   r9 ,  r8
+	lea r9, [rsi+264]
+  mov   rbx ,  rax
+  test   rdx ,  r10
+  sub   r10 ,  r9
+  and   rdi ,  r11
+  mov   rcx ,  r8
+  mov   r10 ,  r8
+	add r11,744
+  add   rbx ,  rax
+  imul   rsi ,  r10
+	xor rsi,2810
+  sub   r9 ,  rsi ; step 14
+        pop rbp
+        ret
+
+func_224:
+        push rbp
+        mov rbp,rsp
+  add   rbx ,  r10
+  add   rcx ,  rdi ; step 1
+	add qword [rbp-32], rdi
+  or   r8 ,  rax ; step 3
+	sub rdx,1789
+
+	cmp rbx,2309
+  imul   rsi ,  r11 ; step 6
+  mov   rsi ,  r11
+  mov   rbx ,  r9 ; step 8
+  sub   rdx ,  rsi ; step 9
+	add rsi,2490
+  cmp   r11 ,  r8
+  test   rdx ,  rdi
+	and r11,2070 ; step 13
+  sub   r11 ,  r10
+
+	and qword [rbp-16], rdi ; step 15
+.l15:	jnz .l15
+        pop rbp
+        ret
+
+func_225:
+        push rbp
+        mov rbp,rsp
+  test   r9 ,  rbx
+	lea r11, [rsi+488] ; step 1
+  xor   rbx ,  r8 ; step 2
+  and   r10 ,  rcx
+  and   r10 ,  r9
+  or   rbx ,  rdx ; step 5
+	or qword [rbp-8], rax
+  add   r8 ,  rax ; step 7
+	lea rdx, [rsi+408] ; step 8
+  xor   r8 ,  rcx
+  cmp   r8 ,  rsi
+        pop rbp
+        ret
+
+func_226:
+        push rbp
+        mov rbp,rsp
+  sub   r8 ,  r10 ; step 0
+.l0:	jnz .l0
+  imul   r10 ,  r8
+  add   rcx ,  rax
+  xor   rax ,  r11
+  xor   rsi ,  rax ; step 4
+.l4:	jnz .l4
+
+	lea rbx, [r10+32] ; step 5
+  cmp   r10 ,  rdx
+.l6:	jnz .l6
+  add   r11 ,  rbx
+  test   rdi ,  rcx ; step 8
+	sub qword [rbp-56], rax ; step 9
+  mov   rdx ,  rbx
+  sub   rdx ,  rax
+.l11:	jnz .l11
+
+        pop rbp
+        ret
+
+func_227:
+        push rbp
+        mov rbp,rsp
+	sub qword [rbp-120], rcx
+  or   r8 ,  rdi
+  or   r9 ,  r10 ; step 2
+  add   rbx ,  rcx
+	lea r11, [rax+0]
+	test r10,3659
+  test   rax ,  r10
+.l6:	jnz .l6
+	test qword [rbp-32], r10
+.l7:	jnz .l7
+  and   rsi ,  r10
+  mov   r10 ,  r9
+	or qword [rbp-56], rcx ; step 10
+
+  or   r8 ,  rax
+        pop rbp
+        ret
+
+func_228:
+        push rbp
+        mov rbp,rsp
+  add   r11 ,  r8 ; step 0
+	or qword [rbp-112], r11
+  imul   rax ,  rsi
+	lea rbx, [rax+160]
+  test   rsi ,  rcx
+  cmp   r9 ,  r10
+	mov qword [rbp-32], r9
+  cmp   rdx ,  rcx ; step 7
+  sub   r11 ,  r10 ; step 8
+	xor rcx,2773 ; step 9
+  imul   r9 ,  rdx ; step 10
+        pop rbp
+        ret
+
+func_229:
+        push rbp
+        mov rbp,rsp
+  and   r9 ,  rbx
+  cmp   rcx ,  r8 ; step 1
+	lea r9, [rbx+512] ; step 2
+  test   rcx ,  rax
+	lea r9, [rax+264]
+.l4:	jnz .l4
+  sub   rcx ,  r8
+.l5:	jnz .l5
+	mov r10,3768 ; step 6
+	imul rbx,3032
+.l7:	jnz .l7
+	mov qword [rbp-72], r11
+  or   r9 ,  rbx
+  imul   rdx ,  rdi
+	lea r10, [rbx+32]
+.l11:	jnz .l11
+  cmp   rdx ,  r8
+.l12:	jnz .l12
+  cmp   r11 ,  rsi ; step 13
+	test rsi,3379
+	add qword [rbp-120], rsi
+	test rbx,3101
+.l16:	jnz .l16
+	add qword [rbp-96], rax
+  sub   rax ,  r9 ; step 18
+	or qword [rbp-104], r11 ; step 19
+
+        pop rbp
+        ret
+
+func_230:
+        push rbp
+        mov rbp,rsp
+  add   rcx ,  r11
+.l0:	jnz .l0
+	or qword [rbp-104], rdi
+  mov   rsi ,  r9
+  add   rdi ,  r11
+	lea rdi, [rdx+480]
+	or rdi,1662
+	lea r10, [r8+376] ; step 6
+	add qword [rbp-48], rax ; step 7
+
+	imul qword [rbp-104], r11 ; step 8
+  add   r8 ,  rdi ; step 9
+  and   r8 ,  rax
+.l10:	jnz .l10
+  imul   rbx ,  rdx
+  xor   rdi ,  rsi
+  imul   rsi ,  r9 ; step 13
+        pop rbp
+        ret
+
+func_231:
+        push rbp
+        mov rbp,rsp
+  test   r10 ,  r11
+  cmp   r9 ,  r10
+  xor   rsi ,  rax
+  cmp   rcx ,  r9 ; step 3
+  and   r8 ,  r9 ; step 4
+
+	lea r10, [r8+432] ; step 5
+	add r9,3360 ; step 6
+	cmp r8,2595
+  cmp   rcx ,  rax
+.l8:	jnz .l8
+	or rdx,3476
+  test   r11 ,  rsi
+  sub   r9 ,  rax
+  test   r8 ,  rdi
+  test   r9 ,  rcx
+  add   rbx ,  rdx
+	sub qword [rbp-72], rbx ; step 15
+  test   r8 ,  rbx
+  and   r10 ,  r9
+.l17:	jnz .l17
+
+  or   r9 ,  r11 ; step 18
+	lea rbx, [rcx+432]
+        pop rbp
+        ret
+
+func_232:
+        push rbp
+        mov rbp,rsp
+  imul   rbx ,  rdi
+  test   rax ,  rsi
+.l1:	jnz .l1
+
+  sub   r8 ,  rbx
+	cmp rax,3657
+  imul   r9 ,  rax ; step 4
+
+	add r11,204
+  test   rax ,  rsi ; step 6
+	or qword [rbp-8], r10 ; step 7
+  and   r11 ,  r9
+.l8:	jnz .l8
+  mov   rbx ,  rdi ; step 9
+	add qword [rbp-96], r11 ; step 10
+.l10:	jnz .l10
+	or qword [rbp-80], rdx
+  cmp   rbx ,  rax ; step 12
+	lea rax, [rdi+184] ; step 13
+  or   r11 ,  rbx
+.l14:	jnz .l14
+        pop rbp
+        ret
+
+func_233:
+        push rbp
+        mov rbp,rsp
+  add   rbx ,  rdx ; step 0
+
+  mov   rcx ,  rdx
+.l1:	jnz .l1
+  and   rbx ,  rdi
+	lea r9, [rdi+256]
+	imul qword [rbp-80], rax
+  xor   r10 ,  rsi
+.l5:	jnz .l5
+	or r9,4063
+	add r10,3825 ; step 7
+	xor qword [rbp-56], rax ; step 8
+
+        pop rbp
+        ret
+
+func_234:
+        push rbp
+        mov rbp,rsp
+	lea rdi, [rcx+464]
+
+  sub   rdi ,  r11
+	sub rdx,4079
+  cmp   r11 ,  rdi
+  sub   r8 ,  rdx
+  add   rax ,  rbx
+	lea rsi, [rax+40]
+	cmp rcx,2123 ; step 7
+  or   r10 ,  r9
+  mov   r11 ,  r8
+	and qword [rbp-40], rax
+  or   rdi ,  rsi
+  mov   rcx ,  r9
+	cmp qword [rbp-88], rax
+.l13:	jnz .l13
+  cmp   rdi ,  r8
+	lea rsi, [r9+192]
+  sub   rbx ,  rdx
+	mov qword [rbp-8], rsi
+.l17:	jnz .l17
+  sub   r8 ,  rdx
+	sub rcx,2810
+        pop rbp
+        ret
+
+func_235:
+        push rbp
+        mov rbp,rsp
+  cmp   rdi ,  rdx
+  sub   rdi ,  rbx ; step 1
+	add qword [rbp-96], rbx ; step 2
+  sub   r9 ,  r10
+.l3:	jnz .l3
+  mov   rsi ,  r11
+.l4:	jnz .l4
+  sub   r10 ,  rsi ; step 5
+  mov   rax ,  rbx
+	imul qword [rbp-112], rdx
+	sub rdi,3855 ; step 8
+  cmp   rbx ,  rsi
+  add   rdx ,  rax
+        pop rbp
+        ret
+
+func_236:
+        push rbp
+        mov rbp,rsp
+  mov   rsi ,  rdx
+  add   rbx ,  r11
+.l1:	jnz .l1
+	xor qword [rbp-96], r9
+  test   r11 ,  rbx ; step 3
+.l3:	jnz .l3
+
+  add   r11 ,  rbx
+	lea r8, [rax+200]
+	lea rdx, [rbx+488]
+  imul   rax ,  r11
+	lea rdi, [rdx+432]
+	test r8,744
+	sub rdi,129
+
+  imul   rdi ,  rcx ; step 11
+  or   rdi ,  rax ; step 12
+  or   rbx ,  rcx
+  test   rcx ,  rax
+	sub qword [rbp-80], r11
+  mov   rax ,  r9 ; step 16
+
+  and   r9 ,  rbx ; step 17
+        pop rbp
+        ret
+
+func_237:
+        push rbp
+        mov rbp,rsp
+	and qword [rbp-24], rax ; step 0
+  or   r10 ,  r8
+	lea r11, [rcx+56]
+
+  and   rcx ,  rdx ; step 3
+.l3:	jnz .l3
+  test   r8 ,  rcx
+	lea rax, [r11+296]
+  add   rcx ,  rsi
+.l6:	jnz .l6
+	mov rsi,733
+  xor   r10 ,  rdx
+  cmp   rbx ,  r9 ; step 9
+	test rdx,2901
+	mov qword [rbp-96], rdi
+  and   r11 ,  r9
+  mov   rdi ,  r10 ; step 13
+  mov   rbx ,  rsi
+  mov   r9 ,  rdi
+	mov qword [rbp-56], rsi ; step 16
+	cmp rbx,2765
+        pop rbp
+        ret
+
+func_238:
+        push rbp
+        mov rbp,rsp
+  add   rdi ,  rcx ; step 0
+  cmp   rbx ,  rcx ; step 1
+  xor   rbx ,  rax ; step 2
+  cmp   r8 ,  r9
+  cmp   r11 ,  rdx ; step 4
+	imul qword [rbp-16], r11
+	mov qword [rbp-32], rdi
+  sub   r10 ,  r9
+  and   r8 ,  r9
+  cmp   r9 ,  rbx
+	test r11,3871
+	lea r8, [rax+176]
+  and   rsi ,  r10
+
+	sub qword [rbp-48], r9 ; step 13
+  or   r11 ,  r10
+
+  cmp   r8 ,  r11
+	sub rbx,3241 ; step 16
+  and   r8 ,  rax
+.l17:	jnz .l17
+  and   r8 ,  rcx ; step 18
+.l18:	jnz .l18
+  sub   rcx ,  r10
+        pop rbp
+        ret
+
+func_239:
+        push rbp
+        mov rbp,rsp
+  cmp   rcx ,  rax ; step 0
+	lea r8, [rbx+264]
+	add qword [rbp-8], r10
+.l2:	jnz .l2
+	lea rsi, [rax+240]
+
+  and   r8 ,  r10
+  xor   r8 ,  rdx ; step 5
+  mov   r10 ,  rcx
+	lea rbx, [rdi+400] ; step 7
+  cmp   rsi ,  rax
+	lea r8, [r11+216]
+  mov   r9 ,  rax
+
+  sub   rdx ,  rax
+	sub rdi,652 ; step 12
+        pop rbp
+        ret
+
+func_240:
+        push rbp
+        mov rbp,rsp
+  and   r10 ,  rbx ; step 0
+	test rdx,2333
+  sub   r9 ,  r8
+	imul rbx,2027
+.l3:	jnz .l3
+  test   r10 ,  rdx ; step 4
+
+  add   r8 ,  rbx ; step 5
+	and qword [rbp-112], rdi
+	lea r8, [rcx+376]
+	imul qword [rbp-88], r8 ; step 8
+  and   rcx ,  rax
+  mov   rsi ,  rax
+.l10:	jnz .l10
+	mov r10,348
+	cmp qword [rbp-16], r9
+.l12:	jnz .l12
+  xor   rcx ,  r8 ; step 13
+
+  sub   rbx ,  rax
+  sub   rcx ,  rsi
+.l15:	jnz .l15
+  sub   rcx ,  r8
+  or   r9 ,  r11
+  and   r11 ,  rcx ; step 18
+  or   rsi ,  rbx
+        pop rbp
+        ret
+
+func_241:
+        push rbp
+        mov rbp,rsp
+  add   r11 ,  rcx
+  or   r11 ,  rax
+  imul   r10 ,  r8
+  and   r8 ,  r10
+  test   r11 ,  rdx
+  test   r10 ,  rcx ; step 5
+  and   rcx ,  rax
+	lea r9, [rsi+216]
+	add qword [rbp-88], rbx
+	lea rdi, [rcx+216]
+	lea rax, [rdi+352]
+	xor r9,53 ; step 11
+  xor   r8 ,  rbx ; step 12
+	test r10,3210
+	xor r11,2967
+        pop rbp
+        ret
+
+func_242:
+        push rbp
+        mov rbp,rsp
+  add   rcx ,  r11
+  test   r11 ,  rbx
+  and   rbx ,  r8 ; step 2
+  test   r10 ,  rdx ; step 3
+
+  mov   rsi ,  r8 ; step 4
+  sub   rcx ,  rdi
+  imul   rdi ,  r9
+  test   rsi ,  r10
+	sub r11,3669 ; step 8
+
+  sub   r10 ,  rcx ; step 9
+	cmp qword [rbp-64], rdx ; step 10
+	lea r8, [rax+0] ; step 11
+  or   r11 ,  rcx ; step 12
+  and   r10 ,  r11
+	lea r9, [rsi+256]
+	xor qword [rbp-40], r11
+  cmp   r10 ,  rax
+	test qword [rbp-88], r9 ; step 17
+	add rsi,2427 ; step 18
+  sub   rbx ,  rax
+        pop rbp
+        ret
+
+func_243:
+        push rbp
+        mov rbp,rsp
+	mov r9,3596
+  and   rdi ,  r8
+.l1:	jnz .l1
+	test qword [rbp-56], r8
+	xor rax,2471
+  sub   r10 ,  r11
+	imul r11,1671
+  add   rdi ,  rdx
+  or   rbx ,  rax ; step 7
+  imul   rbx ,  rdi
+  or   rdx ,  rsi
+  xor   rsi ,  r8
+	lea rdi, [rsi+280] ; step 11
+  add   rbx ,  r11 ; step 12
+  xor   r10 ,  rbx
+  add   rdi ,  rsi
+
+	lea rcx, [r9+472] ; step 15
+  or   rsi ,  r9 ; step 16
+  and   rbx ,  rdx
+.l17:	jnz .l17
+  and   rdx ,  rax ; step 18
+        pop rbp
+        ret
+
+func_244:
+        push rbp
+        mov rbp,rsp
+	cmp rsi,2910
+  or   rdi ,  r8
+  mov   r11 ,  rcx
+  sub   rdi ,  r9
+	sub qword [rbp-88], rdi ; step 4
+
+  cmp   rdi ,  rax
+  xor   rdx ,  rax
+	lea r11, [r9+56] ; step 7
+	or r9,3386
+  or   r9 ,  r10 ; step 9
+	sub qword [rbp-72], rdi
+	lea rsi, [r11+464]
+  sub   r8 ,  rdi
+	and qword [rbp-40], rdi
+  imul   rdi ,  r11
+        pop rbp
+        ret
+
+func_245:
+        push rbp
+        mov rbp,rsp
+  imul   rdx ,  r8
+
+  xor   rax ,  r8
+  test   rdi ,  r11
+
+  xor   r10 ,  rax ; step 3
+  imul   rdi ,  r11
+	xor qword [rbp-40], rdx ; step 5
+  add   rcx ,  rbx
+	lea rbx, [rax+512] ; step 7
+  test   rbx ,  rsi ; step 8
+  sub   rbx ,  r10
+  add   r9 ,  rsi
+  and   r11 ,  r9
+	add r9,2177
+  or   rsi ,  r11 ; step 13
+.l13:	jnz .l13
+  cmp   r11 ,  rcx
+        pop rbp
+        ret
+
+func_246:
+        push rbp
+        mov rbp,rsp
+  add   r8 ,  r9
+  imul   rsi ,  rdi ; step 1
+	lea rsi, [r8+8]
+	cmp qword [rbp-88], rbx
+	imul rcx,1012 ; step 4
+	cmp r10,2148
+	lea r8, [rax+432] ; step 6
+	lea rsi, [rbx+360]
+	sub rdx,3540
+  cmp   rbx ,  rax ; step 9
+
+        pop rbp
+        ret
+
+func_247:
+        push rbp
+        mov rbp,rsp
+	lea rdi, [rax+512]
+
+  xor   r8 ,  r9
+	test rdi,2557
+.l2:	jnz .l2
+  and   r8 ,  r11
+  cmp   r11 ,  r9
+  cmp   r9 ,  rdx
+	add qword [rbp-40], r11
+	add rbx,2528 ; step 7
+	xor r8,2599
+.l8:	jnz .l8
+        pop rbp
+        ret
+
+func_248:
+        push rbp
+        mov rbp,rsp
+	test r9,403
+
+	xor rdx,1230
+	or r9,1081 ; step 2
+  cmp   rdx ,  r8 ; step 3
+
+  add   rdi ,  r11
+  sub   rbx ,  rcx
+
+  mov   rax ,  rdi
+.l6:	jnz .l6
+  imul   r10 ,  r8
+  mov   rcx ,  rax
+	test qword [rbp-48], r8
+  imul   r10 ,  rax ; step 10
+  mov   rdi ,  rcx
+
+  and   rdx ,  r9
+	xor qword [rbp-8], rbx
+  cmp   rcx ,  r8
+  imul   rbx ,  r8
+	lea rdx, [r11+24]
+        pop rbp
+        ret
+
+func_249:
+        push rbp
+        mov rbp,rsp
+	xor rcx,1000
+  mov   rbx ,  rdi ; step 1
+	sub rsi,2524
+	imul qword [rbp-104], r11 ; step 3
+  cmp   rdx ,  rcx
+  imul   r9 ,  rcx
+	imul rax,332 ; step 6
+	lea rax, [rbx+368]
+	or qword [rbp-8], r11
+.l8:	jnz .l8
+  add   rcx ,  rsi
+	test qword [rbp-64], r10 ; step 10
+	imul rbx,1706
+  mov   rbx ,  r11 ; step 12
+        pop rbp
+        ret
+
+_start:
+	call func_0
+	mov rax,60
+	xor edi,edi
+	syscall
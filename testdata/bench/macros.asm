@@ -0,0 +1,1181 @@
+; Benchmark input: macro-heavy code with nested preprocessor blocks.
+
+%use altreg
+%define ARCH 64
+
+%macro op0 2-3 0
+%push op0
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op1 2-3 0
+%push op1
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op2 2-3 0
+%push op2
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op3 2-3 0
+%push op3
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op4 2-3 0
+%push op4
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op5 2-3 0
+%push op5
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op6 2-3 0
+%push op6
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op7 2-3 0
+%push op7
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op8 2-3 0
+%push op8
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op9 2-3 0
+%push op9
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op10 2-3 0
+%push op10
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op11 2-3 0
+%push op11
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op12 2-3 0
+%push op12
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op13 2-3 0
+%push op13
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op14 2-3 0
+%push op14
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op15 2-3 0
+%push op15
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op16 2-3 0
+%push op16
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op17 2-3 0
+%push op17
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op18 2-3 0
+%push op18
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op19 2-3 0
+%push op19
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op20 2-3 0
+%push op20
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op21 2-3 0
+%push op21
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op22 2-3 0
+%push op22
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op23 2-3 0
+%push op23
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op24 2-3 0
+%push op24
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op25 2-3 0
+%push op25
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op26 2-3 0
+%push op26
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op27 2-3 0
+%push op27
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op28 2-3 0
+%push op28
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op29 2-3 0
+%push op29
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op30 2-3 0
+%push op30
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op31 2-3 0
+%push op31
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op32 2-3 0
+%push op32
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op33 2-3 0
+%push op33
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op34 2-3 0
+%push op34
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op35 2-3 0
+%push op35
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op36 2-3 0
+%push op36
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op37 2-3 0
+%push op37
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op38 2-3 0
+%push op38
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+%macro op39 2-3 0
+%push op39
+%ifidn %3, 0
+	mov %1, %2
+%elif %3 > 8
+%rep %3
+	add %1, %2 ; repeated
+%endrep
+%else
+%$top:	dec %1
+	jnz %$top
+%endif
+%pop
+%endmacro
+
+section .text
+
+	op0 r8, 0
+%ifdef DEBUG
+	int3
+%endif
+	op1 r9, 1
+	op2 r10, 2
+	op3 r11, 3
+	op4 r12, 4
+	op5 r13, 5
+	op6 r14, 6
+	op7 r15, 7
+%ifdef DEBUG
+	int3
+%endif
+	op8 r8, 8
+	op9 r9, 9
+	op10 r10, 10
+	op11 r11, 11
+	op12 r12, 12
+	op13 r13, 13
+	op14 r14, 14
+%ifdef DEBUG
+	int3
+%endif
+	op15 r15, 15
+	op16 r8, 16
+	op17 r9, 17
+	op18 r10, 18
+	op19 r11, 19
+	op20 r12, 20
+	op21 r13, 21
+%ifdef DEBUG
+	int3
+%endif
+	op22 r14, 22
+	op23 r15, 23
+	op24 r8, 24
+	op25 r9, 25
+	op26 r10, 26
+	op27 r11, 27
+	op28 r12, 28
+%ifdef DEBUG
+	int3
+%endif
+	op29 r13, 29
+	op30 r14, 30
+	op31 r15, 31
+	op32 r8, 32
+	op33 r9, 33
+	op34 r10, 34
+	op35 r11, 35
+%ifdef DEBUG
+	int3
+%endif
+	op36 r12, 36
+	op37 r13, 37
+	op38 r14, 38
+	op39 r15, 39
+	op0 r8, 40
+	op1 r9, 41
+	op2 r10, 42
+%ifdef DEBUG
+	int3
+%endif
+	op3 r11, 43
+	op4 r12, 44
+	op5 r13, 45
+	op6 r14, 46
+	op7 r15, 47
+	op8 r8, 48
+	op9 r9, 49
+%ifdef DEBUG
+	int3
+%endif
+	op10 r10, 50
+	op11 r11, 51
+	op12 r12, 52
+	op13 r13, 53
+	op14 r14, 54
+	op15 r15, 55
+	op16 r8, 56
+%ifdef DEBUG
+	int3
+%endif
+	op17 r9, 57
+	op18 r10, 58
+	op19 r11, 59
+	op20 r12, 60
+	op21 r13, 61
+	op22 r14, 62
+	op23 r15, 63
+%ifdef DEBUG
+	int3
+%endif
+	op24 r8, 64
+	op25 r9, 65
+	op26 r10, 66
+	op27 r11, 67
+	op28 r12, 68
+	op29 r13, 69
+	op30 r14, 70
+%ifdef DEBUG
+	int3
+%endif
+	op31 r15, 71
+	op32 r8, 72
+	op33 r9, 73
+	op34 r10, 74
+	op35 r11, 75
+	op36 r12, 76
+	op37 r13, 77
+%ifdef DEBUG
+	int3
+%endif
+	op38 r14, 78
+	op39 r15, 79
+	op0 r8, 80
+	op1 r9, 81
+	op2 r10, 82
+	op3 r11, 83
+	op4 r12, 84
+%ifdef DEBUG
+	int3
+%endif
+	op5 r13, 85
+	op6 r14, 86
+	op7 r15, 87
+	op8 r8, 88
+	op9 r9, 89
+	op10 r10, 90
+	op11 r11, 91
+%ifdef DEBUG
+	int3
+%endif
+	op12 r12, 92
+	op13 r13, 93
+	op14 r14, 94
+	op15 r15, 95
+	op16 r8, 96
+	op17 r9, 97
+	op18 r10, 98
+%ifdef DEBUG
+	int3
+%endif
+	op19 r11, 99
+	op20 r12, 100
+	op21 r13, 101
+	op22 r14, 102
+	op23 r15, 103
+	op24 r8, 104
+	op25 r9, 105
+%ifdef DEBUG
+	int3
+%endif
+	op26 r10, 106
+	op27 r11, 107
+	op28 r12, 108
+	op29 r13, 109
+	op30 r14, 110
+	op31 r15, 111
+	op32 r8, 112
+%ifdef DEBUG
+	int3
+%endif
+	op33 r9, 113
+	op34 r10, 114
+	op35 r11, 115
+	op36 r12, 116
+	op37 r13, 117
+	op38 r14, 118
+	op39 r15, 119
+%ifdef DEBUG
+	int3
+%endif
+	op0 r8, 120
+	op1 r9, 121
+	op2 r10, 122
+	op3 r11, 123
+	op4 r12, 124
+	op5 r13, 125
+	op6 r14, 126
+%ifdef DEBUG
+	int3
+%endif
+	op7 r15, 127
+	op8 r8, 128
+	op9 r9, 129
+	op10 r10, 130
+	op11 r11, 131
+	op12 r12, 132
+	op13 r13, 133
+%ifdef DEBUG
+	int3
+%endif
+	op14 r14, 134
+	op15 r15, 135
+	op16 r8, 136
+	op17 r9, 137
+	op18 r10, 138
+	op19 r11, 139
+	op20 r12, 140
+%ifdef DEBUG
+	int3
+%endif
+	op21 r13, 141
+	op22 r14, 142
+	op23 r15, 143
+	op24 r8, 144
+	op25 r9, 145
+	op26 r10, 146
+	op27 r11, 147
+%ifdef DEBUG
+	int3
+%endif
+	op28 r12, 148
+	op29 r13, 149
+	op30 r14, 150
+	op31 r15, 151
+	op32 r8, 152
+	op33 r9, 153
+	op34 r10, 154
+%ifdef DEBUG
+	int3
+%endif
+	op35 r11, 155
+	op36 r12, 156
+	op37 r13, 157
+	op38 r14, 158
+	op39 r15, 159
+	op0 r8, 160
+	op1 r9, 161
+%ifdef DEBUG
+	int3
+%endif
+	op2 r10, 162
+	op3 r11, 163
+	op4 r12, 164
+	op5 r13, 165
+	op6 r14, 166
+	op7 r15, 167
+	op8 r8, 168
+%ifdef DEBUG
+	int3
+%endif
+	op9 r9, 169
+	op10 r10, 170
+	op11 r11, 171
+	op12 r12, 172
+	op13 r13, 173
+	op14 r14, 174
+	op15 r15, 175
+%ifdef DEBUG
+	int3
+%endif
+	op16 r8, 176
+	op17 r9, 177
+	op18 r10, 178
+	op19 r11, 179
+	op20 r12, 180
+	op21 r13, 181
+	op22 r14, 182
+%ifdef DEBUG
+	int3
+%endif
+	op23 r15, 183
+	op24 r8, 184
+	op25 r9, 185
+	op26 r10, 186
+	op27 r11, 187
+	op28 r12, 188
+	op29 r13, 189
+%ifdef DEBUG
+	int3
+%endif
+	op30 r14, 190
+	op31 r15, 191
+	op32 r8, 192
+	op33 r9, 193
+	op34 r10, 194
+	op35 r11, 195
+	op36 r12, 196
+%ifdef DEBUG
+	int3
+%endif
+	op37 r13, 197
+	op38 r14, 198
+	op39 r15, 199
+	op0 r8, 200
+	op1 r9, 201
+	op2 r10, 202
+	op3 r11, 203
+%ifdef DEBUG
+	int3
+%endif
+	op4 r12, 204
+	op5 r13, 205
+	op6 r14, 206
+	op7 r15, 207
+	op8 r8, 208
+	op9 r9, 209
+	op10 r10, 210
+%ifdef DEBUG
+	int3
+%endif
+	op11 r11, 211
+	op12 r12, 212
+	op13 r13, 213
+	op14 r14, 214
+	op15 r15, 215
+	op16 r8, 216
+	op17 r9, 217
+%ifdef DEBUG
+	int3
+%endif
+	op18 r10, 218
+	op19 r11, 219
+	op20 r12, 220
+	op21 r13, 221
+	op22 r14, 222
+	op23 r15, 223
+	op24 r8, 224
+%ifdef DEBUG
+	int3
+%endif
+	op25 r9, 225
+	op26 r10, 226
+	op27 r11, 227
+	op28 r12, 228
+	op29 r13, 229
+	op30 r14, 230
+	op31 r15, 231
+%ifdef DEBUG
+	int3
+%endif
+	op32 r8, 232
+	op33 r9, 233
+	op34 r10, 234
+	op35 r11, 235
+	op36 r12, 236
+	op37 r13, 237
+	op38 r14, 238
+%ifdef DEBUG
+	int3
+%endif
+	op39 r15, 239
+	op0 r8, 240
+	op1 r9, 241
+	op2 r10, 242
+	op3 r11, 243
+	op4 r12, 244
+	op5 r13, 245
+%ifdef DEBUG
+	int3
+%endif
+	op6 r14, 246
+	op7 r15, 247
+	op8 r8, 248
+	op9 r9, 249
+	op10 r10, 250
+	op11 r11, 251
+	op12 r12, 252
+%ifdef DEBUG
+	int3
+%endif
+	op13 r13, 253
+	op14 r14, 254
+	op15 r15, 255
+	op16 r8, 256
+	op17 r9, 257
+	op18 r10, 258
+	op19 r11, 259
+%ifdef DEBUG
+	int3
+%endif
+	op20 r12, 260
+	op21 r13, 261
+	op22 r14, 262
+	op23 r15, 263
+	op24 r8, 264
+	op25 r9, 265
+	op26 r10, 266
+%ifdef DEBUG
+	int3
+%endif
+	op27 r11, 267
+	op28 r12, 268
+	op29 r13, 269
+	op30 r14, 270
+	op31 r15, 271
+	op32 r8, 272
+	op33 r9, 273
+%ifdef DEBUG
+	int3
+%endif
+	op34 r10, 274
+	op35 r11, 275
+	op36 r12, 276
+	op37 r13, 277
+	op38 r14, 278
+	op39 r15, 279
+	op0 r8, 280
+%ifdef DEBUG
+	int3
+%endif
+	op1 r9, 281
+	op2 r10, 282
+	op3 r11, 283
+	op4 r12, 284
+	op5 r13, 285
+	op6 r14, 286
+	op7 r15, 287
+%ifdef DEBUG
+	int3
+%endif
+	op8 r8, 288
+	op9 r9, 289
+	op10 r10, 290
+	op11 r11, 291
+	op12 r12, 292
+	op13 r13, 293
+	op14 r14, 294
+%ifdef DEBUG
+	int3
+%endif
+	op15 r15, 295
+	op16 r8, 296
+	op17 r9, 297
+	op18 r10, 298
+	op19 r11, 299
+	op20 r12, 300
+	op21 r13, 301
+%ifdef DEBUG
+	int3
+%endif
+	op22 r14, 302
+	op23 r15, 303
+	op24 r8, 304
+	op25 r9, 305
+	op26 r10, 306
+	op27 r11, 307
+	op28 r12, 308
+%ifdef DEBUG
+	int3
+%endif
+	op29 r13, 309
+	op30 r14, 310
+	op31 r15, 311
+	op32 r8, 312
+	op33 r9, 313
+	op34 r10, 314
+	op35 r11, 315
+%ifdef DEBUG
+	int3
+%endif
+	op36 r12, 316
+	op37 r13, 317
+	op38 r14, 318
+	op39 r15, 319
+	op0 r8, 320
+	op1 r9, 321
+	op2 r10, 322
+%ifdef DEBUG
+	int3
+%endif
+	op3 r11, 323
+	op4 r12, 324
+	op5 r13, 325
+	op6 r14, 326
+	op7 r15, 327
+	op8 r8, 328
+	op9 r9, 329
+%ifdef DEBUG
+	int3
+%endif
+	op10 r10, 330
+	op11 r11, 331
+	op12 r12, 332
+	op13 r13, 333
+	op14 r14, 334
+	op15 r15, 335
+	op16 r8, 336
+%ifdef DEBUG
+	int3
+%endif
+	op17 r9, 337
+	op18 r10, 338
+	op19 r11, 339
+	op20 r12, 340
+	op21 r13, 341
+	op22 r14, 342
+	op23 r15, 343
+%ifdef DEBUG
+	int3
+%endif
+	op24 r8, 344
+	op25 r9, 345
+	op26 r10, 346
+	op27 r11, 347
+	op28 r12, 348
+	op29 r13, 349
+	op30 r14, 350
+%ifdef DEBUG
+	int3
+%endif
+	op31 r15, 351
+	op32 r8, 352
+	op33 r9, 353
+	op34 r10, 354
+	op35 r11, 355
+	op36 r12, 356
+	op37 r13, 357
+%ifdef DEBUG
+	int3
+%endif
+	op38 r14, 358
+	op39 r15, 359
+	op0 r8, 360
+	op1 r9, 361
+	op2 r10, 362
+	op3 r11, 363
+	op4 r12, 364
+%ifdef DEBUG
+	int3
+%endif
+	op5 r13, 365
+	op6 r14, 366
+	op7 r15, 367
+	op8 r8, 368
+	op9 r9, 369
+	op10 r10, 370
+	op11 r11, 371
+%ifdef DEBUG
+	int3
+%endif
+	op12 r12, 372
+	op13 r13, 373
+	op14 r14, 374
+	op15 r15, 375
+	op16 r8, 376
+	op17 r9, 377
+	op18 r10, 378
+%ifdef DEBUG
+	int3
+%endif
+	op19 r11, 379
+	op20 r12, 380
+	op21 r13, 381
+	op22 r14, 382
+	op23 r15, 383
+	op24 r8, 384
+	op25 r9, 385
+%ifdef DEBUG
+	int3
+%endif
+	op26 r10, 386
+	op27 r11, 387
+	op28 r12, 388
+	op29 r13, 389
+	op30 r14, 390
+	op31 r15, 391
+	op32 r8, 392
+%ifdef DEBUG
+	int3
+%endif
+	op33 r9, 393
+	op34 r10, 394
+	op35 r11, 395
+	op36 r12, 396
+	op37 r13, 397
+	op38 r14, 398
+	op39 r15, 399
+%ifdef DEBUG
+	int3
+%endif
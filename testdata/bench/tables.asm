@@ -0,0 +1,1167 @@
+; Benchmark input: data tables and reservations.
+
+section .data
+
+table_0:
+	dd 6, 19795, 1038, 0x8f2a7bd5, 0, 2, 0x5fe00f81, 46222
+	dd 0xedf074, 54992, 0x83164f03, 5, 0xb0de15c2, 30635, 0x6c0f1b36, 9
+	dd 1, 7, 46499, 0x40e10e85, 0xeb2d2409, 0x76d25fce, 0x36b2f7c6, 4
+	dd 0xc02ae6b2, 6, 0xc4a1f7de, 0xc3ae248a, 0xe812f87, 0x66e21d36, 0x57279ded, 8
+	dd 7, 0xa2870d9c, 21154, 3, 25225, 0xff06834e, 0x115c896c, 0x37c3aef5
+	dd 8, 9647, 0x8d9ff725, 2, 0x52bf779, 2, 0xd6d5d09c, 30943
+	dd 34492, 6095, 49353, 32453, 8, 4031, 7, 4
+	dd 2, 1, 35595, 0xe7317a69, 5, 8, 3, 0x54b41bfd
+	dd 9, 14310, 0x458b654d, 5, 7333, 0x64dd19ff, 0x93fd7067, 8926
+	dd 0xfbad531e, 0x931d767a, 0x6d256b50, 0xb45fa341, 0x1d948777, 0x423a9f72, 0x6bd8a12a, 1
+	dd 1, 7486, 29630, 0xc04e9955, 1, 0x1628b329, 64776, 21139
+	dd 4, 3, 7, 9, 1, 61074, 53275, 4
+table_0_len equ $-table_0
+msg_0 db 'message number 0', 10, 0
+
+table_1:
+	dd 0x2af7fa76, 0xc45d1da9, 0x53806a8, 26782, 0x1015701e, 0x5e71ecb3, 11641, 0
+	dd 0x376766c5, 0x221ff9e6, 0xa4c4dc98, 0x5a57d381, 434, 3, 42479, 47966
+	dd 0xc66f2165, 35319, 0x9b1321dd, 4, 6, 0, 7, 0xabe608aa
+	dd 8, 0x63b5cbb7, 0xfde9cc01, 0xd015dccb, 7, 8, 3, 0
+	dd 32912, 23894, 3, 8, 32203, 8, 5, 31888
+	dd 0xe84babd3, 0xe04bb2b8, 5188, 5, 5253, 1, 2, 34811
+	dd 0x5533a532, 0x736b17ac, 45950, 21251, 29189, 4, 9, 0x2d666a96
+	dd 6, 1, 6, 7299, 0x35925358, 2, 2, 4
+	dd 64153, 0x68dca169, 5, 0x3647e7d3, 5456, 0xb6c76ae, 7, 0xde62fe2c
+	dd 0xb8ccfa72, 20713, 0x2f85ea27, 5, 18124, 0x59324226, 16028, 0x2b546302
+	dd 1, 7, 14724, 4, 0xf6f2d6e7, 6, 61160, 42539
+	dd 64822, 6, 0x1167138a, 57215, 0x5bc40c73, 0x9f6ddbd2, 0x2e071600, 1084
+table_1_len equ $-table_1
+msg_1 db 'message number 1', 10, 0
+
+table_2:
+	dd 33770, 9, 0xab33e01c, 0x593afba7, 0x8f750b63, 43126, 0x5784c9c2, 0x72ff5ed4
+	dd 60714, 8, 6350, 40551, 8, 0xb1212a39, 18001, 5
+	dd 39159, 8, 43872, 1, 3, 0x8b9ec17a, 0x257f4b9b, 56240
+	dd 2, 0xfb42d669, 0x6185db68, 24918, 27884, 0x6619a1bc, 0, 8
+	dd 37191, 0x8ba9b2f2, 0x56b0c4cb, 0x301ebd86, 25742, 3, 8, 63454
+	dd 64127, 0, 14751, 36602, 51523, 43684, 0xa0c48fe2, 0x51a9ccb9
+	dd 0xcc200da0, 0, 0x60638841, 7, 0xfbf4629f, 20674, 0x6c1d82b, 64187
+	dd 0, 0xe070f795, 0x2e4f732a, 0xff1837ba, 0x8d78f859, 0x5b236a63, 5, 58938
+	dd 15294, 0, 58070, 9195, 5, 0x1586383f, 4881, 0xb9767b09
+	dd 0, 0x57ba5a7f, 20247, 846, 0xd0993c3b, 0x99f2e910, 0, 51221
+	dd 0x1f8a348e, 0xb28020ac, 0x574edac8, 35198, 44036, 18947, 27856, 45103
+	dd 26581, 41278, 36622, 0xe5995664, 38530, 8, 2, 0x7866b172
+table_2_len equ $-table_2
+msg_2 db 'message number 2', 10, 0
+
+table_3:
+	dd 21735, 1, 0x2c6f3eed, 0x164ee426, 2, 0x14ec9313, 0xa6e15515, 0xbeaeee22
+	dd 0x3ca28813, 4, 0x65966926, 0x99e78d72, 52627, 0, 9, 0xfaa220fb
+	dd 5, 29358, 0xddc8a0f2, 2, 40997, 6, 0xf55c06b, 8
+	dd 0x25f96a35, 4007, 0xa0a44ea9, 13470, 30553, 0xb225873b, 0xe73b750f, 0xaa09f59
+	dd 60380, 5, 50244, 24108, 39427, 0x9ba4ffe6, 43955, 0
+	dd 0x54d00825, 46338, 19485, 3970, 43252, 8, 50911, 7
+	dd 2, 0x527955fe, 45661, 63669, 34876, 65083, 0x20779c21, 2
+	dd 5, 0, 0x29f54f05, 8, 4, 13652, 0xfd7c5ad5, 0x6ff1503f
+	dd 12775, 13063, 0x1f6fe22c, 6, 6175, 35161, 3, 0x6b7f3b1e
+	dd 0x97fd6d05, 0x7e10f792, 0xa75e8bc8, 40265, 0x5e5a99d9, 6736, 36782, 3
+	dd 2, 0x7037d3b9, 0x6d711b9f, 9, 35563, 0xc4c0abc2, 8, 9
+	dd 0xc3aa4f75, 0x45533c16, 59643, 53826, 0xb47d8999, 0xde661839, 0, 0xd51ba53e
+table_3_len equ $-table_3
+msg_3 db 'message number 3', 10, 0
+
+table_4:
+	dd 12708, 29038, 0x7fc37353, 0x37ee4bc9, 0xb56f53bb, 0, 0x617c60e5, 10576
+	dd 0x884f0957, 0x80428a5e, 0xffea8823, 14111, 8, 0xdb6b99b8, 0x6ffdf767, 0x2e6281bc
+	dd 0xcfe70393, 0x3ec17503, 0xa8692680, 9, 48728, 12778, 28882, 0xf6f9cd57
+	dd 9, 59241, 20648, 3060, 9, 0xbb718f7d, 0xee6dd6de, 39923
+	dd 0xc4ec9d6c, 5, 8, 0xc39616d6, 0xaabfc29c, 29567, 60280, 48911
+	dd 0xb7233e52, 37884, 39799, 2, 0x2c4e4e56, 6, 0xb2355b00, 16200
+	dd 0x6e361c2e, 8, 0x384c233e, 0xc5aa54ab, 2, 6, 0xda23b4a4, 36900
+	dd 0xb016f138, 4, 0xe6ebe60e, 4, 0x2e6304b9, 0xbee7f188, 0x44d5e9f5, 4
+	dd 0xcc5aa33b, 38799, 1, 0x61758db9, 6637, 64497, 0, 0x8b27b66e
+	dd 17954, 1, 54997, 52281, 4, 8, 7, 5
+	dd 7, 0xc73c005a, 0x45ddb466, 0x13385fe3, 28327, 38729, 0, 31615
+	dd 0x5c9c7b06, 0x8820b2d4, 5, 0x7b07e760, 23615, 6, 0x7025cd15, 0x367457c0
+table_4_len equ $-table_4
+msg_4 db 'message number 4', 10, 0
+
+table_5:
+	dd 0x64f9a6c3, 0x3bdeb35d, 2, 0xd0615a8e, 9, 30402, 0xe812cfb9, 807
+	dd 7, 35530, 0x38ed1913, 20285, 0x20e9d416, 0xd3c9e5f8, 6, 0xa60e8c58
+	dd 2, 0x2cd92f13, 0x2fbf06e8, 55650, 2841, 0xfd590ebc, 0x91a1b8c5, 65122
+	dd 0x768d8302, 9, 7, 0x9d9907d1, 4, 42418, 4, 4
+	dd 0xe248c5df, 1, 0xc747e48d, 9, 36412, 3, 2, 31968
+	dd 30356, 4, 1, 22325, 1, 4, 3916, 1
+	dd 56510, 6, 52959, 5, 40695, 63463, 9, 0x559b9a4e
+	dd 0x9adec412, 62877, 48126, 20120, 0x3febfbce, 0x8af4d1f2, 6, 0x76988e5d
+	dd 7, 0x72c7b5fc, 20019, 0x298072f3, 4, 0x91037b75, 7, 3
+	dd 2, 41036, 4, 5647, 0xccdfb8eb, 0xd871671a, 65317, 0xd3f2c9aa
+	dd 2, 34388, 0x5ec5ac40, 0xc6ed9a2c, 0x76f342c2, 11504, 0x7e24592f, 8
+	dd 0x3cb159f3, 0x4aae540e, 0x6b60e0a0, 7, 0, 0xeb2f239b, 6, 1
+table_5_len equ $-table_5
+msg_5 db 'message number 5', 10, 0
+
+table_6:
+	dd 0x5343a7b2, 0xc9096ca0, 62464, 3, 53205, 2, 43907, 0x8200a56f
+	dd 21562, 0x7ddf2cd0, 36407, 2, 9, 0xb90d6ed7, 15902, 8
+	dd 0x5321a149, 5, 7, 5, 2, 55866, 0xb85fb6d2, 0xa1d15ddb
+	dd 5, 0x6ab0047e, 6, 0xf8cac3cf, 7, 41928, 20561, 0xff89ccbe
+	dd 3, 0x15def927, 47441, 50445, 15894, 16770, 0x8873df8a, 0x7b6e7e83
+	dd 5, 16152, 52885, 0xc008bb59, 39602, 5, 48208, 62430
+	dd 8, 53552, 50103, 22755, 0x601cab27, 12486, 0x8598fd2a, 5
+	dd 48320, 49640, 20022, 0x129b1617, 4633, 0x8efdceba, 3, 0xce848e68
+	dd 64064, 0xfb6438a, 0x87360fd6, 25451, 98, 4, 1, 61343
+	dd 0x52fe1a49, 0x3782fad5, 0x6b0c9ee6, 0x2823b2d2, 17186, 1, 0, 47805
+	dd 51651, 64712, 0xe6c84329, 125, 2, 57257, 18147, 52650
+	dd 2, 4, 7, 43050, 0x4e33d213, 5, 2953, 55546
+table_6_len equ $-table_6
+msg_6 db 'message number 6', 10, 0
+
+table_7:
+	dd 4003, 42233, 43654, 38763, 8, 46076, 5, 7
+	dd 1, 0xeaf494f5, 31245, 0xf566af88, 46858, 34977, 11070, 0x7e52fa34
+	dd 5, 9, 3594, 0, 0xcabd9520, 3, 0xd9f63b90, 0x225a9f29
+	dd 0x237ec0f9, 7, 0xf40c66ea, 45231, 2, 0x6c1771ac, 2, 8
+	dd 2, 6, 60939, 9, 0xc32a85d6, 48448, 46899, 9
+	dd 0xa3176223, 26986, 8, 0x8b49fc22, 54902, 8, 0x4f1fbd28, 42884
+	dd 2, 1, 0, 2, 7, 0xcc497427, 0x2fb0e4ad, 0x4cf0e3f8
+	dd 2906, 43633, 1, 46261, 0x9933188b, 0x80b39603, 13505, 0x222845cf
+	dd 0xecd0bed8, 18694, 34296, 5, 53519, 22662, 56479, 4234
+	dd 8791, 8555, 0xc462913d, 15797, 0x798f2bb7, 0xd0dceed, 22025, 8
+	dd 8, 2361, 43407, 4784, 5549, 5, 0x91a1ed7b, 10782
+	dd 6, 5, 6, 9169, 0x302ec3ef, 0xf7e514d, 0xc32fba1f, 5
+table_7_len equ $-table_7
+msg_7 db 'message number 7', 10, 0
+
+table_8:
+	dd 23049, 0xe40ec8cb, 0xb1f8b1ba, 9, 7, 2020, 9, 43575
+	dd 0xcb9f3ad2, 4, 13146, 0x31d31155, 7, 6, 4, 0xfc60d591
+	dd 47897, 0xd7f20ea9, 6, 0xc78bf566, 4, 0xfa12eb2f, 20807, 0x1eb2a9d3
+	dd 9, 57277, 19321, 38762, 61435, 0, 4, 9
+	dd 16568, 5586, 7, 0x9b9f90af, 0x6cae6f4a, 60895, 4, 0x47f8e253
+	dd 5, 4, 0x8745081, 59055, 9, 4, 1, 0
+	dd 0, 9, 0xc1e6cd18, 32931, 6, 0xd6d05505, 0xa9f57bd4, 6
+	dd 59326, 0x16ee4c2c, 5, 0x23bb373b, 0xd5217591, 7, 44957, 0
+	dd 0, 0x633a46f1, 0x45d6f471, 0, 1, 5, 55845, 7472
+	dd 57354, 0, 64602, 30722, 8, 9, 2, 2
+	dd 35091, 0x57c94ce1, 0xee11fe11, 5, 6, 29921, 3, 0xdb5320d5
+	dd 6, 0x215ca16, 2743, 0x2eaf2aeb, 32718, 38745, 55345, 2
+table_8_len equ $-table_8
+msg_8 db 'message number 8', 10, 0
+
+table_9:
+	dd 12285, 0x73fb284c, 0xff9b9d4b, 0x859d60a0, 0, 37644, 42255, 0xbc3cb20b
+	dd 18037, 2995, 5, 24305, 5, 0xcc67f6c7, 20747, 0xe212e52a
+	dd 5, 7, 0x45a101f7, 8051, 0xde46e9ba, 4, 7, 9
+	dd 0xa75c27a5, 0x92541fe9, 12736, 52145, 43376, 34631, 0x8373c50, 1
+	dd 26253, 34426, 0x283f6dfb, 1, 6, 0, 0xcb77a67d, 30102
+	dd 0x7f7aa060, 101, 2, 0, 50974, 0xf2f14541, 3, 1
+	dd 0x8b372cae, 4, 54537, 0, 0xe8505c35, 0x83072a07, 43565, 9
+	dd 9, 5122, 27284, 0, 0xf64ccbbc, 25722, 8, 63760
+	dd 6, 7, 33663, 46837, 53933, 52954, 6, 42849
+	dd 52127, 9, 0xdb399ab4, 6, 33911, 0x9cde8067, 9, 1703
+	dd 5, 0x503c3d89, 6, 8, 0xe32e05dd, 0x6cb056b6, 53856, 2
+	dd 0x3c5081f1, 8, 0x334a7f11, 58852, 37196, 12744, 9, 47918
+table_9_len equ $-table_9
+msg_9 db 'message number 9', 10, 0
+
+table_10:
+	dd 0xc2927bbf, 2, 6, 0x438de1f0, 2, 44920, 61145, 2
+	dd 41959, 3, 40278, 4, 9, 7, 56797, 8
+	dd 0x6804766b, 0x221eafc6, 13720, 3, 13977, 2, 0xaaf19aa9, 0
+	dd 64393, 2, 8, 55766, 50118, 2806, 0, 5
+	dd 1, 0x85bc092f, 15076, 0x424b05b6, 0xbf34b058, 36598, 8, 0xf817598d
+	dd 9, 0xd445a7ec, 0xcc460bad, 56581, 6, 58633, 0x1e208a03, 5009
+	dd 8, 0x7cf6c0ab, 0, 4, 6, 0, 6198, 0x3a6249b6
+	dd 0xc518be, 8, 1, 0xab176022, 3247, 8, 37303, 40682
+	dd 0x5e4257e6, 0x23de1bb8, 0x826a84e6, 0x9f411f65, 1, 31553, 0x1bdb352c, 0x7744be7b
+	dd 0x672acb6e, 0x5d49b513, 26979, 13929, 0x2d54841, 12545, 1537, 0x9585c330
+	dd 8, 0x712790a6, 0x3e9420d4, 5385, 38526, 0xc39d8b25, 0xd20f15cf, 0x9fd153dc
+	dd 6548, 7, 46762, 0x71629b11, 26533, 2, 0xc11ae8d0, 3
+table_10_len equ $-table_10
+msg_10 db 'message number 10', 10, 0
+
+table_11:
+	dd 30028, 58065, 25217, 57072, 59951, 6, 9122, 32480
+	dd 49944, 61929, 1, 1, 4, 0x5aec05dd, 1, 0x4684788e
+	dd 28350, 5, 7, 3, 1, 0x5f6e14fc, 60224, 32307
+	dd 0xc3ba1335, 0x48496542, 7, 57937, 8485, 0xefaf3fbd, 2, 0xea41151d
+	dd 2416, 38177, 0xc9113d5d, 0xaea5d6bc, 0x4a994d78, 52601, 39236, 27315
+	dd 4, 0x764fc5a, 0x375a13e8, 22694, 0xd1007c06, 0x22e0a34d, 1, 2063
+	dd 7, 6621, 0x572db391, 5, 5, 0xf5336aa2, 0xecc7e3d6, 8
+	dd 35155, 46640, 5, 0xc402d0c, 20088, 1, 8629, 5
+	dd 0xb35fda8d, 7, 1, 6, 26917, 6, 56782, 9
+	dd 1, 37180, 0, 0xb0c63347, 8, 57987, 4, 10527
+	dd 12674, 41318, 0, 0xb477ebd, 2, 8, 0x5e521b93, 0xa81147ab
+	dd 6, 0xa568b48f, 0xc60bd679, 57234, 0xc68f8674, 0x96aeb850, 0xc73ab085, 0xd7b991d6
+table_11_len equ $-table_11
+msg_11 db 'message number 11', 10, 0
+
+table_12:
+	dd 0xe25672a7, 0, 2, 60070, 20093, 3, 0xb9d69a36, 56610
+	dd 3, 7, 7, 0xee31c27c, 0x7b106ca6, 0x6d10d2e1, 0xb706568c, 43899
+	dd 8, 0xd8718314, 0x4c5f15d8, 0, 0xcc948384, 0xbcc4882c, 1, 0x7fb85e36
+	dd 4, 0, 0x79955a4a, 0x37063619, 2, 0x93534ca9, 0x79bad9a5, 8
+	dd 3, 7841, 0x37bdd114, 52836, 7, 20726, 7, 18761
+	dd 4, 5941, 0xc2b25b30, 6, 0x6b6dab6d, 9, 8, 57528
+	dd 0xafbf62f3, 18320, 0, 2897, 0xa78c0f7f, 0xb587ae00, 0x3c16a3f6, 64566
+	dd 15581, 8738, 0xe2bc4f0a, 31516, 57244, 0x92fb5e9e, 0x1c39189a, 0x92e2404a
+	dd 0xe0d56c72, 19379, 0xe5438d5d, 48132, 63541, 0x425821ee, 2, 64679
+	dd 2, 1, 50217, 0x53b200d9, 7, 0x214d4cf4, 3, 23720
+	dd 0x2126a4e5, 4, 0xc6900655, 0, 12628, 14018, 23788, 0x8172a4fd
+	dd 0x6ac6c738, 8, 43734, 7, 1, 0xc814b09e, 41401, 17608
+table_12_len equ $-table_12
+msg_12 db 'message number 12', 10, 0
+
+table_13:
+	dd 0, 65136, 4, 36148, 35726, 2, 29687, 33135
+	dd 3, 0x95149e11, 1, 0x5e116572, 0x89cb7a72, 39531, 12611, 0xf9108cef
+	dd 35588, 6, 15973, 0xe890d4e4, 0x2d6ebfa6, 1, 6, 5
+	dd 4, 44112, 0xbde608c6, 3, 3, 0xe95e4b6e, 0xb44372cf, 30668
+	dd 23153, 0x159cefb4, 11812, 6, 0xba43b27b, 0x1b4b5050, 0x9b9daf31, 4
+	dd 3, 8, 60637, 0x2d61a8cd, 0, 8, 43077, 49870
+	dd 1, 56749, 0x52be47ff, 9, 23383, 0x3d4d2786, 34108, 0x2c0701ab
+	dd 55670, 53437, 6506, 0xad693d48, 8, 37278, 0, 48485
+	dd 0xe681786c, 4, 1, 0x174deff5, 0x844e510b, 35080, 58198, 0x16d18877
+	dd 0, 0x20f95b68, 0xb9acab7b, 49969, 0xb8577c36, 5, 8, 59140
+	dd 0x10c47281, 0, 0xbb25ae00, 52623, 0x73ab0c9b, 8, 53104, 0x7110cb8f
+	dd 2, 23228, 6575, 0x7e3f3821, 6, 0xdb6072dd, 8, 22346
+table_13_len equ $-table_13
+msg_13 db 'message number 13', 10, 0
+
+table_14:
+	dd 0x36e40041, 20765, 0, 38533, 5, 5, 3, 32704
+	dd 0xdf6abdda, 0x768d3cfb, 0x36b31af5, 0x67670181, 3, 7, 58691, 6383
+	dd 1, 42860, 19569, 0x514d9998, 5, 2, 10043, 0xea2b6f77
+	dd 1, 5754, 0xb0501df7, 0x8419ae55, 50464, 39457, 4, 0x6c3ad41
+	dd 5582, 0xe0006e31, 0xc5e3b80e, 0xb0382489, 0xa1139264, 0xa2cdbf8a, 0xee5c20d1, 49772
+	dd 27602, 0x11121dd, 0x8580a529, 38256, 5, 6241, 5082, 1
+	dd 6, 0x9982d33d, 2, 3, 11967, 0x744e2421, 4749, 0x82058291
+	dd 0x559ad631, 0xe60cef4a, 51625, 61017, 7, 47149, 2569, 10013
+	dd 8, 24689, 50448, 37737, 0x1a476357, 4, 7, 7
+	dd 0xfc58d93, 0x35bdf4c6, 0xe66a1eee, 0xf81109c2, 0x4a217c6, 0, 2, 1
+	dd 7, 0x16854a27, 8, 5, 12962, 60632, 4, 0x37a60e66
+	dd 0x8dd7ebe4, 2, 9, 1, 7, 1429, 5, 56287
+table_14_len equ $-table_14
+msg_14 db 'message number 14', 10, 0
+
+table_15:
+	dd 5, 6937, 48553, 5, 9617, 41337, 26746, 9
+	dd 0, 24590, 0x842a223f, 56696, 0x43b1d8d7, 7, 0x7f1f926, 3
+	dd 0xe9b63ab9, 5494, 0xc6138da8, 47990, 0x654afe78, 7, 7, 0x52da6151
+	dd 0x1ca34647, 8, 0xe033106, 0xd0074cbf, 12859, 7, 7, 40031
+	dd 0x49cf713b, 9, 0xdb22734b, 0xe9b4cea5, 46922, 3, 59622, 54224
+	dd 0xebaaee6d, 4, 56914, 0x3506ef27, 22325, 4, 0, 22705
+	dd 4, 0x49f75829, 5202, 9, 2, 0xdebb7e0c, 0xa69ab421, 5
+	dd 0xd511b1a2, 22515, 0x3fa6d07b, 2, 25366, 25106, 37571, 9
+	dd 17667, 50957, 1128, 3, 7911, 0xe0c6ad48, 0xbc16f417, 63299
+	dd 0xc197ce15, 0x187a96b0, 40565, 0x582fa8b0, 62329, 0xfabcd003, 0xd4e0be0b, 9
+	dd 19795, 13325, 0x5a2e77e8, 33958, 4, 0, 0, 0x2b010c14
+	dd 0x7fbac123, 30650, 3, 60089, 0xfdbbbda6, 0xa81569da, 45076, 8735
+table_15_len equ $-table_15
+msg_15 db 'message number 15', 10, 0
+
+table_16:
+	dd 1, 56894, 0xd41ed3ac, 0, 0x6c2f4eff, 6750, 56358, 0xe4f67908
+	dd 5, 0x299b3ab, 6, 10197, 38484, 4, 4, 61197
+	dd 2, 0x31a8454d, 3, 33408, 0x93cb8e2c, 0xc33cb233, 0xcede7989, 51062
+	dd 3, 5, 0x3eaf9680, 9020, 5, 27242, 0x361bfc05, 5
+	dd 0x5d66047d, 9, 43932, 0xdf6b27eb, 20315, 27828, 13777, 0xf81215ae
+	dd 9, 0x5b310e59, 34093, 0x5c2a81b5, 0x478e9133, 3, 36537, 0xc0759979
+	dd 19535, 21547, 38786, 23830, 8, 0xc8cfe3d4, 0x72e34f39, 0x627e46d1
+	dd 58859, 0x8cbc6776, 16457, 12562, 0xe43784dc, 4, 0xa8885c84, 0x89eac768
+	dd 0x43b2bb2a, 0xb9f6981c, 0x4bc5f85f, 0xa5c1cc8a, 28407, 9, 0x5d12dafb, 52250
+	dd 27318, 60953, 17484, 64139, 57654, 5, 2, 36431
+	dd 2, 0x470d66dd, 40493, 24816, 34917, 54032, 39884, 13801
+	dd 4, 52231, 2618, 2, 29455, 0, 11364, 0x746fcd46
+table_16_len equ $-table_16
+msg_16 db 'message number 16', 10, 0
+
+table_17:
+	dd 0x744f8284, 1, 0xdaad38be, 0x8bf18b02, 0xea19b8d9, 15465, 1260, 28352
+	dd 10717, 28373, 8, 35246, 8859, 0xf171b31, 52308, 9
+	dd 6, 0x6796dd3d, 43107, 1, 0x1d80b321, 1, 0xd962f853, 3
+	dd 9, 9, 15589, 0x9e8730bb, 8529, 23646, 36095, 0xc8b6fb1e
+	dd 5, 39498, 46480, 13847, 0, 7, 5, 17415
+	dd 3, 2, 14691, 0x6ec4c895, 4, 0x8c66e7ed, 31513, 0xc101f84c
+	dd 5, 4, 0x1de68129, 55090, 5, 1, 5, 275
+	dd 16034, 9, 0xfea18b85, 12887, 9, 0, 0, 7
+	dd 0x447ef72, 4, 0xc19ed064, 43233, 0, 4, 3, 52740
+	dd 0x9bb6ccec, 34936, 17510, 0x425c5e2f, 61380, 52678, 0xf339312d, 9
+	dd 15486, 5, 2, 31321, 33279, 55404, 51938, 0xa0302cb6
+	dd 62195, 2, 7, 3, 0x86c471af, 3, 5, 0xb23cb132
+table_17_len equ $-table_17
+msg_17 db 'message number 17', 10, 0
+
+table_18:
+	dd 39199, 0x45f286c5, 5, 0x96d4ef16, 0x55b606c3, 20108, 41149, 3
+	dd 0x7dc36274, 0x30d57cbb, 3, 54630, 2449, 2, 3, 0xea7905b8
+	dd 48328, 0xa6c02f7c, 0x26005ad, 6, 7, 0x2d2d41b5, 31445, 32998
+	dd 1677, 53525, 9, 2, 34309, 0xd2f1299f, 6, 8
+	dd 8, 48205, 58125, 18682, 9, 3, 55325, 21029
+	dd 11310, 37018, 0x662f4a32, 0xfc68ced, 8, 57961, 41945, 0x49d156a0
+	dd 32601, 0, 7, 7959, 48651, 0x7f0889a3, 0x6d774f57, 45790
+	dd 0xfb0b4102, 9, 55365, 7, 0x2645b564, 37028, 5737, 22879
+	dd 8, 22689, 47707, 7, 65026, 8, 6, 0x7ccc75fe
+	dd 4, 0, 0xe233e745, 30091, 3, 0x235a9c4c, 8, 0xcd950355
+	dd 51849, 1, 0x322fb5e8, 2, 3, 4, 0x8f879be4, 56845
+	dd 38908, 61597, 39520, 7, 0xd9bd083a, 7, 17308, 50654
+table_18_len equ $-table_18
+msg_18 db 'message number 18', 10, 0
+
+table_19:
+	dd 0xf2eb2ecf, 0xa5dc823c, 12420, 22122, 24113, 9, 0x9feb39db, 9
+	dd 1, 7, 32827, 0xb61c37d3, 0, 10650, 58576, 33028
+	dd 3, 39634, 58035, 2, 0x6d45f692, 55733, 0x60992e94, 0xbcb78ed7
+	dd 5, 0xaf3f9783, 0x188f4c38, 0x2c9834f0, 7, 8803, 17337, 9
+	dd 0x3fef8ab1, 49965, 6, 36586, 5, 20207, 13144, 11058
+	dd 9, 0x59df3cab, 1, 0xfd35f040, 8, 5, 0x2648f237, 6
+	dd 44052, 0x6e489473, 7, 2, 0x20fee31a, 7271, 0x356bff81, 1
+	dd 7, 0xbadf8808, 2, 0x9bb72b25, 0x170490ff, 0x94768357, 0x518d8ae0, 17957
+	dd 0xc271f0c2, 0, 0x9162c0d8, 0x909d8560, 13207, 2, 4, 7
+	dd 0x1218f3e4, 0xab497311, 0x144652fd, 4, 3, 10529, 9, 7
+	dd 1, 0x6f662465, 28722, 0x1a608a68, 39809, 52550, 0x8da10bb4, 0xd1af9030
+	dd 4, 23473, 0x75f677a5, 0x783ea527, 7, 0x70d34d52, 5, 0xcc00750d
+table_19_len equ $-table_19
+msg_19 db 'message number 19', 10, 0
+
+table_20:
+	dd 1, 2, 9891, 6519, 9, 5, 8, 9
+	dd 5606, 5, 0x889c2db7, 3, 0xa08f6c22, 2582, 47885, 4609
+	dd 45744, 0x58c39cc3, 0x274490be, 5, 62488, 6, 5, 0xcbf52b10
+	dd 5, 0x1cf110c, 0x26a60737, 0x581c7bea, 34734, 0xea9d10c2, 13497, 57722
+	dd 54846, 9, 16064, 57638, 12200, 3, 0xf140611d, 6
+	dd 6, 0x5731d42e, 0xdf594a7, 7, 4182, 12547, 9, 2
+	dd 25346, 1, 15393, 0x71e4a642, 1, 1, 6, 55278
+	dd 25412, 11534, 2042, 57944, 0xa250da44, 0x3c959e02, 0x81031994, 21788
+	dd 0x9feb3e78, 6449, 0x704fdc4e, 59621, 33255, 48140, 7, 32826
+	dd 3, 9784, 0x45d8a7f9, 12710, 7, 0xbd6c130f, 0xac1831d4, 8
+	dd 0x64cbd6cb, 5817, 37211, 0xe3f3a08b, 3, 0x41856292, 0xd3202c3b, 0xb0e1213e
+	dd 8, 51823, 58900, 6, 3, 0, 3, 0x99e7e1d6
+table_20_len equ $-table_20
+msg_20 db 'message number 20', 10, 0
+
+table_21:
+	dd 7, 19541, 53118, 0x2a1282b, 0x228da97b, 18617, 1, 0x6a6977d4
+	dd 0x4cb351d5, 58863, 0x931e9cf0, 9, 0xd4dbfca, 60309, 0xcd82e6dd, 9
+	dd 4, 43728, 6, 0, 6, 56915, 9, 0x7a73ecaf
+	dd 5, 6, 9391, 9833, 6, 0x3e1876ed, 15292, 8366
+	dd 0xf181518f, 4, 0x5e6706f4, 5, 43637, 5, 60477, 9
+	dd 0x7342be2d, 18567, 0xc4410233, 9, 39474, 0x19f00d6c, 0xc8b54760, 0x993b024e
+	dd 0xbcce9111, 0x902c6615, 0xb12a4b9b, 0xe03b5de8, 10210, 9, 8291, 8
+	dd 49221, 2, 0x25cb5216, 0xe9e41ecc, 65422, 42886, 3, 2
+	dd 3, 22339, 46179, 7, 0x6523eb13, 0xaeeb5f64, 14213, 27937
+	dd 12729, 0, 0xc6ed3d5b, 3702, 4173, 7, 2, 2
+	dd 8, 55219, 5, 35788, 2, 0x66e54314, 0xae11f61c, 46793
+	dd 5, 1, 0xfd47e32a, 0x28027fb8, 0xe63fb116, 7, 5, 0x8b32c843
+table_21_len equ $-table_21
+msg_21 db 'message number 21', 10, 0
+
+table_22:
+	dd 53703, 4, 9599, 2, 35973, 0xd3028380, 2, 1
+	dd 6, 2679, 0x5481fb12, 28867, 9, 0x3642907e, 4770, 0xd77d1172
+	dd 0x888143d, 0xa746f662, 0x2fa04b0f, 0xff1f7d6f, 40231, 0xbbfe111d, 8975, 3742
+	dd 30738, 0xb7bb4c77, 0xa1a41dec, 64298, 40816, 16691, 45833, 26421
+	dd 1, 14690, 18292, 46188, 0x19202887, 4, 26535, 51733
+	dd 0x4e6350db, 0xfb6d07bb, 14024, 0x8187e14, 28160, 8, 2, 35749
+	dd 8977, 0x8370e47, 0x651d6295, 63029, 0x25c4e2c0, 0, 0x9c6accc4, 6
+	dd 9, 26526, 0xe7be8602, 0xe3adf129, 0xda98fd3, 65476, 4, 53436
+	dd 7, 0x49c8a34, 0x34e821e2, 0xa4860826, 60509, 0xfd1a6b6c, 48345, 53775
+	dd 0xaea8b4bb, 31132, 0, 0x946cdd0e, 0x512d5311, 0x7d65c7ea, 0, 0x51c921f8
+	dd 0xe22eda5b, 16960, 2, 8, 0xd6375406, 3, 0, 0x5a5a8461
+	dd 0x4e510418, 0, 0x597d6e12, 9, 0x86481bf1, 36075, 0x5f05a763, 0x7e4d2761
+table_22_len equ $-table_22
+msg_22 db 'message number 22', 10, 0
+
+table_23:
+	dd 7, 56549, 28065, 23008, 0x79d9077e, 0xa0cc39ce, 0, 0xd80c6163
+	dd 0xcc167f8, 0xbf55cf4d, 5, 5776, 0, 22957, 20029, 53342
+	dd 0x17bc0175, 7, 24374, 0xf1d4944f, 48507, 0x3a721ade, 5, 25054
+	dd 0x7d4c9900, 0xc2fdcfeb, 0, 0xdc711531, 0, 0x4ec8888a, 0x903238d4, 0xc14ba6f2
+	dd 29341, 6, 0x3368c1ab, 0xbfff6506, 46193, 37169, 1, 50959
+	dd 7, 2, 3, 55445, 2, 10343, 6, 0xb5e388a1
+	dd 9, 0x54c897d2, 6, 8, 53040, 0xbbec173f, 40524, 1
+	dd 28665, 0xd084983e, 6, 63257, 56728, 10267, 6, 9
+	dd 6, 0x22d2c439, 16302, 0x366eded2, 46941, 40487, 1, 0
+	dd 0, 0, 58805, 6931, 0x23f9edb8, 51410, 4, 8
+	dd 31345, 8, 60076, 3229, 0x7c373d33, 0xcdb15b0a, 55692, 9604
+	dd 0xa0d4a652, 0x3f10812c, 8, 0x841e1085, 34142, 0xa8f5e853, 56465, 13635
+table_23_len equ $-table_23
+msg_23 db 'message number 23', 10, 0
+
+table_24:
+	dd 17152, 8, 8, 5, 2, 7612, 6, 64288
+	dd 0xd1459c15, 0xcba6a3ea, 1, 0xbbc05249, 22933, 3, 23198, 18492
+	dd 0x791c6cfe, 3, 0x5008e23f, 6, 5, 19255, 31270, 6088
+	dd 3, 14519, 18518, 3, 0xd43af85e, 0x3bf34efe, 1, 8
+	dd 13935, 9, 46427, 1, 8306, 772, 0x9a4b8fd6, 8
+	dd 32873, 12576, 6, 0x32f34657, 0, 4, 10534, 0x18b904b
+	dd 30696, 1, 2, 0x46666726, 2, 6982, 47381, 34630
+	dd 7, 5652, 6, 1, 21003, 9756, 0x6ae60481, 3
+	dd 8, 8, 0xfbb6ec41, 0x396e0268, 0, 1, 0x7989549b, 0xc444ceca
+	dd 24607, 63923, 35955, 7, 5, 34363, 0x16a70dff, 0xbc9d2388
+	dd 62262, 18089, 4, 54389, 31746, 4, 0x6031b193, 0x25ae5319
+	dd 0, 9, 25759, 47686, 19988, 6, 46406, 7
+table_24_len equ $-table_24
+msg_24 db 'message number 24', 10, 0
+
+table_25:
+	dd 59415, 9, 2, 0xf8156121, 63305, 8, 6, 39462
+	dd 8, 32672, 7, 3, 33066, 0x519ccff2, 2, 55086
+	dd 0xbfc3fb18, 20359, 33885, 49554, 7116, 41425, 3, 9
+	dd 8, 50128, 0xe61f93c6, 10030, 3760, 0xbcce259c, 0x12e64066, 59345
+	dd 7, 0xd0db3d62, 8, 53197, 2, 15072, 0xa0a9c875, 2
+	dd 1, 22996, 7, 0xdf2f84fc, 0xcc18e89e, 0x233f09ca, 0, 1
+	dd 4, 27865, 4, 63199, 0x7573d01f, 4587, 1, 0
+	dd 35127, 48885, 6689, 4, 0x26104481, 0x3550508e, 51415, 6782
+	dd 8, 3, 2, 1, 0xef721467, 0x411b8a43, 24276, 16194
+	dd 9, 47191, 8, 37541, 0xae154b36, 15062, 36999, 9
+	dd 0x3bc4216d, 0, 55590, 8, 63327, 0x3dd118fe, 0x181f6a5a, 0
+	dd 39821, 5, 0x9b9f4a41, 0, 10090, 65399, 0x9aa2abc, 55262
+table_25_len equ $-table_25
+msg_25 db 'message number 25', 10, 0
+
+table_26:
+	dd 47466, 1, 0xc7b7c409, 49529, 5, 0x87b1b42a, 0xc46d5707, 35283
+	dd 7, 0x43766630, 0x7a9561b2, 6, 2, 0xff85e73f, 6, 3
+	dd 5, 39266, 6, 5, 6, 51693, 3, 0xa6eee883
+	dd 38363, 12266, 2, 0xf585bedf, 5, 6, 9, 3
+	dd 0xef8ef389, 0x767a87be, 45876, 950, 0xaa619afb, 11413, 0x6559ad50, 0x573b950e
+	dd 2, 2, 63178, 0xbc467b90, 0x760c5d6c, 0x97a15825, 0xd0f0938f, 56519
+	dd 3, 42154, 64966, 54104, 0x3975c5ec, 7, 0x61e3c73b, 33573
+	dd 22433, 0xeedb8d7c, 0x2583ea76, 0x1233c5f, 22553, 8, 5, 0x4170c00a
+	dd 8, 6, 9, 4, 64736, 2619, 0x421bd39e, 1
+	dd 0x90e976b5, 0xf90d0ebc, 34879, 1, 2, 1, 15204, 0xa19d1a2
+	dd 0x78512dc5, 3, 0x347fce36, 4, 3, 0, 0x1bc77aa6, 61319
+	dd 5380, 0x6a02a321, 0xa8347f7, 8, 20616, 20758, 0xb1af51c9, 0x32456f93
+table_26_len equ $-table_26
+msg_26 db 'message number 26', 10, 0
+
+table_27:
+	dd 5, 0x8f08b482, 0x29ea7579, 0xc2ec1966, 8, 0xc69742d4, 0x8ffbde1c, 0x35da7631
+	dd 22943, 591, 38028, 3, 49170, 35382, 18677, 0x997b2d8b
+	dd 6, 0x2c011011, 42547, 0x48f6e7d8, 0x86cb6d48, 6156, 22020, 0xc5a4fdeb
+	dd 1, 0, 32906, 6, 0x539e5bc8, 6, 16846, 1560
+	dd 0, 2, 40544, 0x1baf6c2, 3, 3, 0x2406d02, 0x60bd57b2
+	dd 8, 0x79d6c4, 56290, 21587, 36783, 9, 29023, 812
+	dd 7, 0x37917b5a, 0x95f2f07f, 1, 0xa4cdaf56, 0xfcd35174, 0xbf0a22f1, 0xb8ed652d
+	dd 8, 7, 52041, 0, 8, 49236, 20892, 4
+	dd 48373, 8296, 63964, 2, 31271, 1, 0x20967971, 2
+	dd 0x59b8aa97, 291, 8, 0x9661f750, 0x80b87645, 37548, 45191, 0x2c104a07
+	dd 6, 2296, 8, 3, 0, 4, 0x2f42d174, 8
+	dd 990, 4, 3, 43778, 26753, 0x900b99f4, 0x3841c36a, 6
+table_27_len equ $-table_27
+msg_27 db 'message number 27', 10, 0
+
+table_28:
+	dd 0xcf7e188e, 8658, 19312, 0x73c24f20, 7, 46485, 4, 61512
+	dd 55755, 0xb2466bda, 18714, 4, 20346, 62092, 0xe0bc5dbe, 0x72c7a618
+	dd 64181, 0xf194776c, 15041, 1, 49622, 24196, 0x1e4fc423, 0xdec2b026
+	dd 9, 53639, 50174, 50141, 7, 0x1e88fce4, 2, 0xf4499c7c
+	dd 64405, 0xd9b54db7, 4, 63833, 25646, 9, 2, 7
+	dd 0x228acfa6, 0x7e71951f, 0xcb5780ca, 2, 4159, 0, 5112, 5
+	dd 12131, 4674, 8, 0xd302879, 4, 6, 5876, 0x68d2c703
+	dd 0x69cfcc0a, 0x300a2fef, 0x2c2dae8, 1, 47694, 0x44013aa7, 24595, 7
+	dd 0x20cfd5f5, 48991, 5, 41426, 0xeb8fb98, 18122, 0x3d44f8b8, 1593
+	dd 7, 5, 23618, 1, 63701, 2, 8619, 15424
+	dd 1966, 47919, 0x5ca00e2d, 61152, 0, 1, 0xf44f2fd3, 0xd880acdf
+	dd 0x9b396f55, 6, 0x8176c803, 0x479de53, 0xb47cbd11, 6647, 6, 6
+table_28_len equ $-table_28
+msg_28 db 'message number 28', 10, 0
+
+table_29:
+	dd 6, 5, 39991, 14869, 6029, 9775, 2, 0
+	dd 7729, 59521, 0x73b51a75, 48340, 17036, 2, 8, 0xc3d758dc
+	dd 5296, 0xa1669552, 40462, 0x41fb83ed, 1, 9, 0x91812169, 53942
+	dd 0, 44692, 55350, 0x963fbf54, 64122, 4, 0xa8a76389, 9
+	dd 46567, 1, 0xec01d16c, 0xafaa41ef, 0xdda6fc67, 0xcd4ba4f2, 0x85ec0e4c, 8
+	dd 4513, 21929, 31667, 0x2d5e600f, 0x53d27daa, 0x6c9c8aa7, 6, 8
+	dd 0x9fa65147, 51972, 999, 0xc912cdb9, 3, 0x2b8fc42b, 21800, 40790
+	dd 0x4d9b06cb, 0xe7385e67, 5, 0x454e99ca, 47122, 1, 0x8b2b22f9, 7
+	dd 0xdbd0cbe, 15708, 0x9bc40fad, 0, 2210, 47845, 35720, 61622
+	dd 4, 47645, 60083, 0xed631016, 5, 42267, 0x617fd00f, 0x180992c2
+	dd 23248, 0x881550b0, 20671, 0x53093b6e, 4868, 0x1631bcd6, 6, 0xe28e8f9
+	dd 7, 11231, 12145, 49310, 6, 64997, 4, 10659
+table_29_len equ $-table_29
+msg_29 db 'message number 29', 10, 0
+
+table_30:
+	dd 0xe1ccb60a, 6, 0x83395db9, 4, 0xbadf67c1, 26266, 0x37dc4ae0, 3294
+	dd 1, 0x25497d8e, 5, 0xd67c5805, 23045, 4, 9293, 62440
+	dd 0xa317faa2, 48172, 42854, 20145, 42546, 25178, 0xdd638742, 0x6e06e357
+	dd 5, 32153, 13358, 4, 35798, 5, 0, 8
+	dd 0x4598795c, 1, 8, 0xd31becda, 30704, 31601, 1, 9
+	dd 1, 0xfd0cceca, 0x980698e, 58198, 6, 3, 0, 5214
+	dd 3, 9, 7, 1, 6, 5, 0x695cfc79, 8
+	dd 743, 7, 0x3041d7fd, 15286, 3, 7, 3, 48350
+	dd 6655, 0x97061ef7, 8, 35915, 0x43db44b3, 1, 3, 4
+	dd 9, 48181, 11733, 4, 6189, 1915, 8, 7
+	dd 4, 58733, 4, 22011, 26695, 0x15fb003b, 2, 2
+	dd 4, 9, 0x6c18471b, 9, 2, 8785, 40305, 0x3377751d
+table_30_len equ $-table_30
+msg_30 db 'message number 30', 10, 0
+
+table_31:
+	dd 59743, 45755, 4, 6, 2, 64384, 5800, 5
+	dd 37521, 5, 0xee0331c2, 0xbf86fbac, 53813, 4, 0xb6b09a75, 50459
+	dd 2, 2, 0x500986f5, 0xd75a5251, 19669, 0xf4c4bb3e, 5, 0x7c5bfecd
+	dd 0x1814970e, 0xa799332a, 0xd1fb29c2, 5, 0xd701ef04, 6, 0xada1ed27, 8
+	dd 0xcacdc97, 58084, 17975, 0, 0xf1db7fd0, 2, 54130, 0xe54ad278
+	dd 0, 0x34b2e62, 6583, 0x95241453, 0, 7, 0xbac52d7e, 0
+	dd 35779, 3644, 0x3d1f4f98, 0x99ae382, 0x68772a3b, 0x4b6de431, 26531, 29342
+	dd 7579, 9, 4, 1, 6, 8, 9, 0xd8d1b46e
+	dd 63633, 0x89e24562, 4, 7, 7, 1, 0x61803023, 0x5b9dcf0f
+	dd 32429, 30720, 0x9f73ee71, 4, 2, 0xa6ba67eb, 23064, 4369
+	dd 53172, 0xefa4390, 1, 18627, 28356, 64193, 0x5bc0a325, 7
+	dd 6, 53451, 3758, 9187, 57233, 0x1ae0795f, 63347, 62732
+table_31_len equ $-table_31
+msg_31 db 'message number 31', 10, 0
+
+table_32:
+	dd 0, 9, 38052, 0xf783c43d, 4, 6, 21885, 0x68fede06
+	dd 0x21d604d0, 0x701f5d80, 0xf61dd7f7, 9, 3343, 11329, 0x6151500a, 0x724ae5ef
+	dd 31978, 46950, 0x23dcc688, 0x30616c6e, 4881, 2, 36512, 21726
+	dd 35642, 2, 26597, 5, 6095, 0, 1, 0x2ba0f0fd
+	dd 2, 0xdfb482ec, 54531, 7, 4, 0x15367245, 61855, 21089
+	dd 4074, 0xb247de52, 3, 40120, 0xe96d0752, 6, 53893, 40098
+	dd 3, 42882, 9, 2, 0xdc5e060f, 32352, 0x20c7a8eb, 13145
+	dd 0, 55568, 3, 4, 21866, 44220, 3, 0xd89b8a93
+	dd 34108, 0, 0x8a7dbae3, 3, 41615, 8, 0xee4f7db8, 28607
+	dd 0x5256c58d, 0xe992f2da, 8, 68, 41346, 2, 2, 0x9a5f1e36
+	dd 3, 12313, 0x7ba3e8a1, 3, 6, 0x27cc7247, 9, 36100
+	dd 9, 9, 0x85216eae, 4, 39011, 0xe5cbdc9a, 22953, 8
+table_32_len equ $-table_32
+msg_32 db 'message number 32', 10, 0
+
+table_33:
+	dd 7, 3, 20358, 3, 0x1402a60b, 0x943728fa, 10281, 0x26b99c14
+	dd 2091, 2, 60143, 0x42094cc9, 7, 0x4233259f, 52266, 4
+	dd 0x837f3339, 0xfcfc5851, 0x1ae5a323, 0xcc85f8ae, 2, 8, 33298, 0x806dbb42
+	dd 0xa60af90f, 21303, 64407, 0x3b263d40, 0x752573c, 2, 8, 0x4bfe16e5
+	dd 2, 0xb741aac2, 7, 0xbabf9c62, 4, 18811, 52688, 26633
+	dd 1, 2, 0, 2716, 53948, 29298, 10466, 2
+	dd 2, 8, 0x2fbbad18, 61396, 0xd5d0b573, 27349, 0xd2ab7cc1, 11713
+	dd 50260, 0xdbaf55d1, 49731, 5, 0, 6, 0x93877ab0, 2
+	dd 3, 28867, 4, 9, 0x1e6738d3, 1, 0x79aed2b9, 40777
+	dd 0xc5f1fffd, 49429, 1, 58840, 6, 0x6067028f, 3, 16332
+	dd 32297, 13611, 5, 2, 7, 2, 0xf6597690, 4
+	dd 1, 2406, 61455, 0x2093e12d, 63761, 1, 0x944a9fe9, 5
+table_33_len equ $-table_33
+msg_33 db 'message number 33', 10, 0
+
+table_34:
+	dd 0x464fc104, 55328, 61976, 8, 26697, 7840, 0x27a00937, 0x80de585b
+	dd 0xbdaae452, 3, 0xa96d0757, 0x5fa087d5, 4, 49902, 5, 9
+	dd 0x67423df8, 24179, 0x7b14a5d6, 8, 54686, 0x28dfd0fd, 16540, 0x760ea037
+	dd 5, 0x24fadd1a, 0, 8, 57783, 38637, 0x98a8cacd, 0x866dd76c
+	dd 0xad11883e, 6, 0xff3d6eba, 0xdbe7496b, 16496, 0x9013d33a, 20165, 0x390b85b5
+	dd 0xe6972a75, 4, 0xc0d0bb91, 9, 9, 36796, 1961, 6
+	dd 7, 7, 0x27036bff, 0xf2de7c12, 10105, 4, 0xc525d31d, 6922
+	dd 4, 0x4a240542, 16243, 0x4e0512f6, 0xe8e80aa5, 45865, 8, 0x1b17de08
+	dd 2, 56199, 16572, 7, 5, 6, 1, 21688
+	dd 0xe43b36a7, 5, 6, 1, 5, 19501, 41776, 12981
+	dd 0xe3fd8983, 0x1452f63d, 1, 51724, 9, 20952, 19860, 5
+	dd 6, 57803, 0x9021b8cc, 41312, 2, 1, 60001, 0x2fc06103
+table_34_len equ $-table_34
+msg_34 db 'message number 34', 10, 0
+
+table_35:
+	dd 48378, 9, 0xbff55802, 0xa50b95a2, 0x28cfd2f4, 2773, 1, 0x22ef0af0
+	dd 43407, 61513, 0xc83f31b8, 0x724dc90e, 0xebeaa85c, 30207, 1, 6
+	dd 6, 6, 0xcee7fbe6, 7, 3, 0x619a9f30, 0, 62138
+	dd 0xc279ab04, 62464, 2, 0x7b8bff7, 0x35eebab6, 4, 37082, 28814
+	dd 1665, 0x237f6f7, 9, 0x24e92ea3, 35906, 3, 41305, 17996
+	dd 0x6aa2380a, 37189, 5, 0xe5087745, 0xa6fa3a6e, 35164, 4, 8
+	dd 61431, 0, 0xee6d8fb4, 6, 1, 0x507e7ad1, 2, 5597
+	dd 0xf60a36d4, 9, 4277, 44996, 47253, 3, 0xfb986c1f, 0
+	dd 0xddfee2f0, 3, 0x9cd075a6, 48216, 53714, 0xc80ccfb1, 6, 49359
+	dd 9, 15188, 0xaf085356, 1, 0xbaacbb95, 16159, 2, 2
+	dd 2086, 0x911a83b7, 4673, 1, 26398, 0xbd06e5bc, 3, 23821
+	dd 0xfb95da2b, 9, 5, 0x42df4827, 0xc0432c71, 0x8395afad, 0, 0x7b564883
+table_35_len equ $-table_35
+msg_35 db 'message number 35', 10, 0
+
+table_36:
+	dd 9, 8, 16636, 2, 29460, 0x1661ca34, 3, 31066
+	dd 3, 0xdc72274d, 59740, 14628, 19535, 49889, 2, 2
+	dd 9, 3, 1613, 16261, 0x13064bbf, 0x7880a806, 0x2e049b95, 48193
+	dd 1, 0x4263b72c, 0x865ed0f5, 46444, 0, 5, 3, 0x39ed797b
+	dd 30597, 1524, 2020, 9, 7, 0xf860829d, 0xb378fd34, 0x59670fa8
+	dd 53556, 32923, 44641, 26896, 5, 8, 61775, 9
+	dd 0xce459576, 51915, 0x2ffe5e31, 0x3237315e, 0x96b9c249, 33861, 0x52739e68, 44321
+	dd 8, 1, 7862, 0, 2, 3, 0, 36984
+	dd 0xe7654c64, 17339, 4, 10774, 10239, 4, 38625, 8
+	dd 0x81f04c65, 7, 0x440acbb6, 0xe482d4dc, 0xd91edf6a, 22586, 65437, 0x9420f5f6
+	dd 7, 0, 1834, 0xe83036f1, 10280, 10713, 9, 58487
+	dd 9, 0xe67c9c65, 23333, 0, 0xa138dcbc, 1, 58395, 5
+table_36_len equ $-table_36
+msg_36 db 'message number 36', 10, 0
+
+table_37:
+	dd 60049, 0, 0x2e4765c, 5731, 4, 1956, 16914, 6
+	dd 10738, 4, 35808, 61123, 0x1d828e49, 32300, 27311, 8
+	dd 0x651f7f8, 9, 3603, 5, 0xdbdc9e6b, 35282, 9, 39678
+	dd 3, 58964, 36358, 4, 0x7b53bdb6, 29417, 31150, 24332
+	dd 5, 0x8528f6de, 57855, 59440, 1, 0xe665c759, 24871, 0xde26607b
+	dd 0xef2da0f6, 52145, 4, 4, 0x81c1a727, 23227, 49271, 0xf1cb1cbe
+	dd 3899, 63039, 32618, 44683, 6, 8, 0x26578d7b, 25873
+	dd 0, 9126, 52217, 36700, 8, 0xef556f14, 0x5f2de537, 0x2efeac4
+	dd 8, 0xfaec4d17, 0x10e837ed, 50050, 0, 2, 7, 38710
+	dd 0x25f6a619, 0x4f8b1c02, 0xdf6f37df, 0x86530066, 0xfd3be2c8, 0, 0x8ec78135, 0x32e94239
+	dd 29655, 9, 4, 0xc11fadd3, 0x7209e8ef, 49845, 3, 3
+	dd 0x8bb1219c, 0x7317bc97, 0x6763b1b8, 4, 0xef3064b2, 0, 0xc26b9ca9, 15605
+table_37_len equ $-table_37
+msg_37 db 'message number 37', 10, 0
+
+table_38:
+	dd 7, 39397, 0x94cd1327, 6, 2, 8, 4, 3
+	dd 3337, 0x11fbe588, 4, 8640, 0x44c446dd, 52350, 63280, 5
+	dd 26386, 7, 0xf0375a74, 0, 0x52f86806, 0xc75d5c6f, 47775, 33771
+	dd 0xbee4fde8, 686, 4, 54174, 17166, 0x6d5d5d9a, 0x1f4890fd, 1
+	dd 29186, 30753, 7, 41845, 0xc0ded159, 11782, 935, 0x77dba44f
+	dd 4019, 0x57271030, 51329, 0x2244cb05, 8, 0xb31748c9, 5, 15801
+	dd 42221, 0x4e0c3252, 0x60508b18, 0x99179576, 7, 0, 38297, 0x3f4f902a
+	dd 2, 4, 0x2328179c, 0xd23cf474, 0x6e34893f, 1823, 0, 0x21343f0
+	dd 52492, 48204, 6, 6735, 0x223bed58, 6, 1, 0x65007f8e
+	dd 0x4ee6a1be, 0xfb7d837c, 0x6689e64d, 22608, 7, 0x73052eba, 5, 0xb223851b
+	dd 26342, 0x5959da53, 0xf1019543, 30330, 9, 0xa6af63b9, 4, 0x2e24c476
+	dd 1, 0x36c10169, 2, 0x858b959d, 0x602f0db3, 0xd82859f8, 9, 54737
+table_38_len equ $-table_38
+msg_38 db 'message number 38', 10, 0
+
+table_39:
+	dd 13054, 0xd60c6230, 7090, 4, 28110, 59210, 26904, 0xba2d950
+	dd 19280, 6, 0x91e8d4c6, 1, 5, 28088, 22407, 5675
+	dd 41101, 26207, 0x6a3a4081, 0xa7c68a3c, 1060, 0x5fb61281, 0x71709eaa, 0x563139bd
+	dd 0x9ffdf4e, 0x7327e81, 29291, 3, 0xc2fc2856, 61195, 55779, 6
+	dd 6, 4, 8, 8, 0x37f3ddb8, 0xe85a23fb, 2, 54175
+	dd 58567, 5, 42935, 35906, 49004, 7567, 0, 0xa791b614
+	dd 8, 0xe02351a7, 41139, 6, 0x16576b38, 0xa9dd62f8, 65496, 0x5f638e49
+	dd 6, 1, 0x35b7f71e, 6, 0x9c4cdc36, 0x8323cc88, 1, 1920
+	dd 5, 1, 64059, 0, 0xa030a63c, 0x920a64cd, 0xe921c7d7, 0x9efcd254
+	dd 6, 0x81a3d24c, 0xe03b1dcc, 2, 8, 43877, 0xdd2446c1, 0xf4983248
+	dd 6586, 24252, 9, 0x8df223cd, 6, 9, 64642, 0x48ce3d6
+	dd 6, 0x7bf081fc, 0x25e1693e, 46665, 0, 64402, 6, 0xfacd862c
+table_39_len equ $-table_39
+msg_39 db 'message number 39', 10, 0
+
+table_40:
+	dd 56708, 0x9be1b01c, 6, 0xeec269b7, 0xf727a89, 0x28de4e9c, 0xbecb19ac, 5
+	dd 7, 0x2884e7b5, 5, 3967, 0, 0, 0x8133b7d6, 30614
+	dd 7, 7, 0x4d88627f, 14942, 0xb2a0dc37, 60435, 28960, 0xf69b1c0a
+	dd 0x3b886a66, 7, 0x3c6ca6bb, 0x339cdf8, 0x78bca203, 1, 0x70fdaf59, 9
+	dd 32205, 3, 0x2633256a, 0x3e9cf1be, 41929, 7355, 0, 7914
+	dd 1, 0xd5cf8884, 5, 5257, 35617, 2, 0xd55b9f08, 57200
+	dd 6, 22888, 0x8f6a6ac3, 4, 2, 4, 27018, 58360
+	dd 0xa3b6fe2c, 0xd17ec74c, 6509, 1, 24726, 33427, 32145, 49904
+	dd 8, 0xa0a3fbd5, 8, 0x4b70b165, 0x4cb92510, 0xecd92994, 2, 23326
+	dd 13139, 24827, 13874, 59065, 63985, 40762, 61640, 0x1f738c21
+	dd 0xbf0006ef, 6, 9, 57751, 0x5ffb2472, 0xacd81c33, 5, 47676
+	dd 44519, 0x833f2e08, 1, 0x741e8800, 9, 0x5f7b93ac, 6980, 5
+table_40_len equ $-table_40
+msg_40 db 'message number 40', 10, 0
+
+table_41:
+	dd 7, 2, 49210, 61264, 4, 3, 1, 61867
+	dd 46773, 0, 8, 0, 8, 0x2c899155, 7593, 21667
+	dd 0x9da15bef, 0x6a3c31d8, 64775, 0x294cc17d, 22530, 3, 12730, 0x65e6980b
+	dd 0x9f585fa4, 0, 0xbb8a0e66, 7, 54, 0x867f73d3, 0x535e4121, 5564
+	dd 0xfeb5dc07, 0x2935785e, 4, 57663, 53290, 7, 0xecd7a4a1, 1
+	dd 0xed32dbb5, 7, 0x11095b98, 54514, 23940, 44219, 33965, 0
+	dd 31767, 0xe90359bd, 52984, 23845, 23934, 0xb07a5601, 0x7ce994a6, 0xc8e4e9fb
+	dd 0xcb764586, 17480, 8170, 3, 51010, 8, 34447, 0
+	dd 1579, 0, 0xc65bed05, 6115, 10394, 48396, 0, 7
+	dd 8, 0x63400ee8, 54970, 7, 0, 5, 20460, 56676
+	dd 8, 0xcae18159, 0xf3430e0c, 0xb5523147, 0x29f56be3, 0x7488ed1a, 7, 0x1edda764
+	dd 6, 5, 61432, 0x4029c343, 45225, 0x23626d82, 10304, 4519
+table_41_len equ $-table_41
+msg_41 db 'message number 41', 10, 0
+
+table_42:
+	dd 6, 7, 0x532da2da, 8, 4, 5, 0xd44538f5, 12606
+	dd 4, 7772, 5, 0xe05b998b, 0x2580bb95, 6, 17644, 44922
+	dd 9757, 24865, 0x4bc077c1, 64122, 9, 0x92ff471f, 0x15665a54, 5
+	dd 24205, 0xbd534164, 0xc4673524, 51616, 31050, 14535, 7, 7
+	dd 0x601f4854, 41014, 0x4e966917, 0xcd46f8c2, 32693, 0xbc3448ce, 2, 5
+	dd 39713, 51097, 6, 5, 0x29e7f8fd, 60547, 55824, 7
+	dd 0x4d31f993, 0x55f2de8c, 0x5f43db48, 48340, 7, 0xb8c66ff9, 0x7e534763, 2
+	dd 49878, 47387, 53090, 0x17308327, 11499, 12622, 47280, 35709
+	dd 4, 8, 0xb3e76d82, 7, 0x314febba, 7, 61914, 9
+	dd 9, 0x3e30d129, 0xe9d4158a, 0xf74a08ac, 0x88f26ad, 0x76ad04de, 5, 7
+	dd 3938, 32583, 2, 0xf26da636, 23261, 0x3cdf4d51, 48048, 4
+	dd 0x7b4ced6d, 0xfe880c7f, 60974, 0xa0e2b809, 5, 0xcf5be7fa, 0xac212c2d, 3
+table_42_len equ $-table_42
+msg_42 db 'message number 42', 10, 0
+
+table_43:
+	dd 0xa7a432b4, 0xed1baae, 0x2d3aad2b, 6, 7, 0xcf065f00, 13195, 4
+	dd 0x2134e72c, 59036, 28783, 9, 54328, 0, 2, 0x71a8c780
+	dd 17322, 3, 8632, 61659, 0x9482f7e5, 45202, 2, 8
+	dd 5, 0xaaed670b, 5, 0x3ad135ea, 2, 0x35287d8b, 4, 4
+	dd 0xb7b83f32, 6, 2, 0xd7e1d209, 7903, 57590, 6, 0x230964a3
+	dd 61264, 49656, 1, 49895, 0xef6d3477, 5782, 0xdd13a924, 1
+	dd 0x13795e26, 26334, 0x7945714e, 0x6dcf4120, 0x1c777690, 0x29d6d866, 7, 4
+	dd 34862, 2813, 0xc3f0244d, 63184, 32971, 0x6ee4b8b, 0, 46402
+	dd 17574, 33696, 0x14892c9c, 7, 0xb5dd864d, 0xf6fd2554, 6, 0xa2094a39
+	dd 0x7b5deb13, 0xb0202cf8, 0x69c23445, 3, 15323, 0x572af9e6, 7346, 46025
+	dd 8, 7, 4998, 2, 18287, 6, 0x9d747424, 0xf565b497
+	dd 17182, 33072, 7, 45337, 5568, 0x3fd4c8f4, 0x84f0972e, 22668
+table_43_len equ $-table_43
+msg_43 db 'message number 43', 10, 0
+
+table_44:
+	dd 3, 58384, 55768, 0x47d9ede9, 0x68946e9, 0x5cf64789, 0xe5ef75a5, 4
+	dd 12539, 48863, 55740, 53984, 0, 9, 53537, 15430
+	dd 21190, 0, 6, 4, 0x75023098, 39941, 45783, 49941
+	dd 0x8be30e94, 0xbf16424c, 1, 50607, 0x760d42d6, 20656, 52451, 0xed2f8fd8
+	dd 6, 29678, 0x991cb655, 6, 0x20ae5c06, 3, 0x606656b, 0x4f08d528
+	dd 0xbbe61946, 0, 1342, 0xd0619bb7, 1, 0xa7938de3, 0xbf9916d2, 5
+	dd 3, 46353, 0xa2896e4b, 30564, 6, 0xdaf47fe7, 0x9d8e369e, 0xf95957aa
+	dd 5, 13941, 0x672b2550, 0xc9402e88, 1, 64200, 38361, 4
+	dd 5, 5, 0x19eac5d1, 3, 0x59153925, 0xca9cbce6, 38964, 45590
+	dd 6, 57185, 0x562e43bc, 27268, 9, 4, 0x60d2bb18, 64345
+	dd 0xdbe303a, 0x75ecd6c2, 4908, 2, 39248, 0x2dc42cbf, 3574, 0x7ac7c586
+	dd 6, 59833, 0xfdb659ed, 1, 0x3ca440ae, 49111, 0x6280876a, 7
+table_44_len equ $-table_44
+msg_44 db 'message number 44', 10, 0
+
+table_45:
+	dd 0x580d13b9, 0x7e8f7c6d, 61707, 4, 0x1e70b4b3, 39551, 1, 0
+	dd 6, 5, 0x6627ebc7, 0x50bf352f, 21814, 0x54031a8b, 12401, 5
+	dd 1, 0x19f2f3a4, 0xdaacdf3a, 0x516a1f16, 55510, 7614, 0xb2280264, 5
+	dd 42915, 27928, 0x3ae6a45e, 0xf91b45be, 34349, 25793, 3, 8
+	dd 50551, 0xf8b4f336, 0x497dd524, 0x1e32dd33, 0xe8f03584, 0, 41006, 0x686dff87
+	dd 0xe4cf5b54, 0x6c5faee3, 10324, 46903, 527, 4, 7391, 6767
+	dd 3, 6, 0xc225dc8b, 0, 7803, 0xaa33bd72, 47243, 0xd410cb33
+	dd 0xa9ebeba5, 35119, 0xfb994452, 36729, 64658, 16856, 0x6915055e, 0x9a754fa2
+	dd 36192, 6587, 0x3103db52, 0x6317449e, 0x89877503, 6, 0xc222f8f9, 62550
+	dd 4, 5, 0x8924345, 47736, 0x6b2aaa02, 9, 0xfc36d2a8, 13599
+	dd 0x66f26a45, 4, 0x4f855426, 7, 1, 0xf3c91d17, 2895, 0x870c6dd8
+	dd 61144, 1, 9, 0x470a6dff, 8, 38883, 0x6a5868cc, 335
+table_45_len equ $-table_45
+msg_45 db 'message number 45', 10, 0
+
+table_46:
+	dd 0xfcd97af7, 14007, 0x6d4d43b9, 0x9ed39413, 29957, 37027, 0xf0b46449, 9
+	dd 4186, 0x4221c603, 15812, 18900, 31154, 0xdbcd4fce, 9, 0x678771d5
+	dd 3, 45832, 0xa838ba76, 5, 0x8d257c7d, 4, 0x996bd914, 7
+	dd 0xde6de27a, 10433, 6, 0x6d56b1da, 7, 0x5e83afff, 1, 19851
+	dd 0xc4e54329, 4, 0x5d8642e2, 0x4b265fd9, 9, 57646, 0x599ab7e9, 11263
+	dd 51564, 25755, 8288, 37827, 9919, 7, 24752, 41934
+	dd 0xd63f1a11, 0x451e706e, 39858, 59130, 1, 59057, 43074, 43421
+	dd 1, 7, 13943, 0x7ea98d09, 32296, 5638, 0xc08d5aa4, 0x3e6576c9
+	dd 47220, 33606, 0x587a1f23, 4937, 4, 7, 0x9390b39a, 25075
+	dd 21953, 0x959d454f, 38617, 5, 0, 0x1b62c2a2, 0xcfaac474, 0xcc730db8
+	dd 5200, 0xb52bc082, 0x42f1077c, 35513, 9503, 9, 0x59e0538b, 62387
+	dd 10137, 2, 4651, 3, 1, 7, 0x171057c5, 0x199f7c89
+table_46_len equ $-table_46
+msg_46 db 'message number 46', 10, 0
+
+table_47:
+	dd 0x73e4a401, 1, 32814, 11892, 1, 56896, 0xfc657e5f, 4
+	dd 4, 0x8619e5e3, 0xad46251c, 58661, 8, 44747, 0x6f9fe3ba, 6
+	dd 5, 0x605bcac, 5, 0xff794590, 0x8cfc5642, 65239, 0x6ce4b1da, 11971
+	dd 43760, 29488, 0xe2607e28, 42866, 14313, 6, 0, 5
+	dd 24021, 57158, 29169, 0x425bd08f, 2, 14192, 1, 0xdbe9cac1
+	dd 0x22cb4a7b, 0x4b6f5b9a, 0, 0xc341043b, 1, 1233, 3, 1
+	dd 64558, 0x4be4288e, 0x2e60cbc3, 0x57e2ad77, 3, 0, 4, 34732
+	dd 56056, 33674, 0xaaff5aee, 0xf39aa8c0, 0x7ead83cf, 3, 0xf10852c3, 8654
+	dd 1, 23744, 18183, 3, 0xa231755c, 0x329c8c3c, 6, 0x5b789c4e
+	dd 4215, 0xe157addf, 0x72265267, 28792, 51312, 27479, 0x1e27ef2b, 33678
+	dd 49309, 5661, 0x9dabc88e, 2, 35276, 9, 0xa72f791b, 8
+	dd 28782, 0xb3c56155, 64417, 26841, 5, 9505, 0x1f670935, 0
+table_47_len equ $-table_47
+msg_47 db 'message number 47', 10, 0
+
+table_48:
+	dd 4, 5, 0x941fe176, 59511, 9, 52617, 17939, 6268
+	dd 6, 3, 8, 7, 55911, 5806, 0x3bba624c, 1
+	dd 0x8795ed41, 0xcdc5a9f9, 54960, 32449, 17463, 0xcdacff17, 59825, 7
+	dd 0, 3, 5, 9405, 22589, 0x1e90452b, 1146, 54132
+	dd 32134, 6, 17470, 26640, 665, 52082, 43114, 6
+	dd 0x30428e5d, 0xb4dc4e48, 56536, 0x563c4c7a, 5, 3235, 4790, 3
+	dd 55727, 54268, 38169, 40108, 0xca5db380, 48628, 9, 3
+	dd 0x414000b9, 8, 9, 0xc9a74214, 0xa579569f, 0, 9, 0x298cfea8
+	dd 0x42bd6ad2, 40903, 9, 3, 0x935fea98, 0x6a53ec46, 0x285b77ef, 0x42264833
+	dd 0xa86d6c6, 2, 0xb841f429, 0xb1d15f89, 1, 0x5623ee95, 3186, 39396
+	dd 0xefe6e8fd, 8800, 0x28c52ded, 37251, 0xdac2f67c, 32608, 3, 0x57594ff6
+	dd 26129, 0x354910f8, 7, 9439, 52330, 5, 0xcc2cf4e1, 0xdbef9642
+table_48_len equ $-table_48
+msg_48 db 'message number 48', 10, 0
+
+table_49:
+	dd 0xd4d66b8d, 50163, 1, 56497, 38698, 0x7a57378c, 22594, 0
+	dd 0x67bc6d94, 0x36de831c, 30321, 0x27783392, 0xe61f09f2, 20367, 6500, 7200
+	dd 35264, 60169, 0x99da8ba4, 9, 5, 11689, 0x20e84757, 8
+	dd 2, 6, 6, 36717, 1, 9, 2375, 0xc0a22e4d
+	dd 6, 0x914e34f9, 0xe657196, 0x4d0cd673, 0x410df12e, 60709, 63226, 10357
+	dd 0x5f2148a2, 1, 5, 7, 51630, 8, 56899, 48650
+	dd 26131, 63888, 9603, 2, 21160, 64144, 4, 7
+	dd 0x9a05e84a, 0xd4815839, 0x5ff2dadb, 0x948c3d81, 9, 56849, 0x62b70544, 47867
+	dd 7, 4, 4, 54045, 27338, 0xf0d96f36, 1, 0xfc93778f
+	dd 0x21f4d231, 0x3aceeea8, 4, 0x8102a5b0, 27790, 5, 0x6e3c5be0, 0xe596bb07
+	dd 7, 0xec5e6f4b, 0xdba1d180, 0xb4318f1, 2, 3944, 0xa79e623c, 64643
+	dd 60543, 0x39a3fef3, 0x3de075d, 8, 10917, 0xf2dba84e, 40912, 0xded9bd16
+table_49_len equ $-table_49
+msg_49 db 'message number 49', 10, 0
+
+table_50:
+	dd 0, 0xaf5aa09b, 7, 0x70fcf45d, 5, 1, 1, 20524
+	dd 0x5104538, 0x52649c81, 50150, 36835, 0x26fcf998, 0x4ef7f7b9, 7, 53767
+	dd 0x7f1d93cc, 0xa1e81327, 0x859b45cb, 6, 4, 0xf19d6147, 0x941f30c8, 0x1fde7b92
+	dd 41214, 10053, 0x5c8e1e15, 0xd4192572, 8, 0x3ebd9e48, 7, 0xce9eca40
+	dd 8, 3, 0x60e9a60f, 5, 0x7ed11166, 0x145d73ce, 64641, 48853
+	dd 0xe29f20e6, 4, 4, 2194, 7, 8, 0x26089e61, 8
+	dd 51036, 1, 50664, 1, 0xe3be04e8, 0x936193cc, 0xc468ec65, 4
+	dd 15527, 6, 5, 4, 0x54596a9b, 0xed88ceca, 0xf0e0ab7f, 0x50e8a5a1
+	dd 0xbf818e6b, 0x8effc4d8, 0x114c4efa, 1, 0xe8c84937, 0x1276dd5f, 34938, 0xb79b27f5
+	dd 0xe0a5ae9a, 0, 0x2cac1abe, 39829, 0x54a97e37, 0xb08558c, 5, 51081
+	dd 2, 1, 0xa4ed9498, 39249, 33007, 13482, 53456, 0x262213a1
+	dd 2808, 4, 0xb81adc75, 21406, 0xd898b458, 42058, 8, 0x52f00256
+table_50_len equ $-table_50
+msg_50 db 'message number 50', 10, 0
+
+table_51:
+	dd 5, 0xb037df54, 0xc8e40794, 0, 51675, 5, 36821, 8
+	dd 3, 9456, 1926, 0x6ffb23d7, 0, 9107, 2, 1
+	dd 1, 4, 42526, 4542, 7, 1466, 2, 7
+	dd 9, 46628, 0x323f0ee3, 0, 10662, 5, 0, 0xe1e6e862
+	dd 22385, 0x5924fb42, 23379, 0x23882ae2, 0x1768292a, 8, 43022, 0x807c5020
+	dd 807, 8, 49935, 0xe37eff02, 8, 2, 0x2bffdb64, 0x550b8bd3
+	dd 2, 0xef3ab545, 6, 2, 17524, 2, 15020, 54924
+	dd 0xbec200b9, 4, 4, 26826, 0xa8dbd1b5, 2, 3, 4948
+	dd 8, 32125, 38609, 0xde4f32c0, 9, 8, 60002, 0xfed1afe
+	dd 9, 0x705c4dd2, 0x5a0e87e4, 0x8307bc22, 26876, 60029, 7, 0
+	dd 0x8ffdd93f, 0xbfdf67d6, 35796, 0x26da0449, 1881, 0x2e60879, 5, 4923
+	dd 10749, 8, 18778, 1, 7, 0xe994f065, 0x283d8301, 5
+table_51_len equ $-table_51
+msg_51 db 'message number 51', 10, 0
+
+table_52:
+	dd 7, 7, 0, 0, 4, 65020, 704, 0xf4a1a382
+	dd 37215, 10627, 0, 0xf23c10c1, 23906, 0x8b8c52f2, 0xc90bbb5d, 0x355c439f
+	dd 1, 0xafa93239, 0x274fe9c6, 4, 0, 33611, 7, 22360
+	dd 0x49d19a94, 28168, 3, 0x943774bc, 20443, 33127, 0xba06ae72, 2
+	dd 9, 1, 0xfb887a94, 1, 0x6c9655d7, 34598, 43515, 39059
+	dd 8, 1819, 2, 0xae64051a, 0xa92afebe, 1, 0x6861221b, 0xe1053a7
+	dd 55619, 0xb10990c4, 0xf5cac035, 19396, 8, 9, 0x73194ec6, 8
+	dd 4, 24636, 4, 9, 8, 0, 8, 3
+	dd 5, 29113, 11867, 25745, 61831, 0x22f7c31b, 0x28ddc05b, 4
+	dd 0x5252a3a7, 0xf3ef9263, 0x696cc753, 0xf55a49aa, 8, 45743, 0x9e291934, 0x5d789dc2
+	dd 43498, 0xdbfe2b8c, 9, 26215, 40254, 39692, 7, 0x37ebad57
+	dd 10227, 31323, 0xc93330ff, 42123, 2, 0x1cf91770, 0xe48ebfca, 48140
+table_52_len equ $-table_52
+msg_52 db 'message number 52', 10, 0
+
+table_53:
+	dd 27311, 23463, 10525, 0xd553a70, 8188, 10196, 59810, 48291
+	dd 0xc2ab3bef, 0xcb7f4d66, 4, 6, 0xea462493, 65157, 30905, 7
+	dd 0xe9690130, 33582, 61537, 3, 1, 4, 0xe897aabd, 47396
+	dd 6, 0xb4a49c65, 2, 0xe59681f7, 46267, 5, 4, 0x625f20b7
+	dd 5642, 0xf659addc, 0xa1773296, 0xa27b3326, 26176, 52306, 56458, 50370
+	dd 0xc7ce44b, 0x516fc8d9, 0xc3b09b25, 41734, 0xe10fd1f3, 0xbf211ecd, 31481, 0xb91d9225
+	dd 0x22776265, 0x984e9c17, 0x2aed2ab6, 47526, 8, 7824, 49655, 8
+	dd 38588, 0xae7c9c1a, 0x70ab5458, 0xb0ecb98c, 2, 0, 0x2447e8c0, 0x7a70a634
+	dd 14892, 12967, 0x572b414a, 8033, 0x588b1f0b, 52920, 0x825c6108, 8
+	dd 42749, 4, 889, 0, 0x1bfe115f, 0x3c23b5e, 8, 3
+	dd 4, 33881, 0x367468bd, 28362, 4, 2, 11377, 0x8bdd5563
+	dd 47224, 4, 55847, 1, 25769, 0x684e0c12, 7, 4
+table_53_len equ $-table_53
+msg_53 db 'message number 53', 10, 0
+
+table_54:
+	dd 9412, 0x7e2f57b2, 0xf2928080, 0, 0x8d4b9526, 3, 3, 2
+	dd 4474, 0x15be8f29, 3101, 48550, 44273, 11519, 9, 59573
+	dd 63025, 0x1b34321, 12288, 1, 35609, 43244, 956, 1
+	dd 0x1b6e150b, 0xf3dad504, 0x4eedb43a, 0xdca5a200, 0, 1, 0xe403b805, 0x96b196eb
+	dd 8, 0xdf99997f, 2, 9, 0xbc0af489, 8, 23552, 5
+	dd 48697, 0x34e51709, 0x8b3bfdba, 0xd9c8c5b4, 15505, 6387, 0xaa6d8057, 25051
+	dd 0x7be26810, 62033, 11232, 9, 6, 0x7002a98f, 2, 48540
+	dd 0x6d349f30, 23135, 0xbf411511, 3, 0x1ec515b0, 0x4192ab58, 43277, 18081
+	dd 3, 0x1445798a, 3807, 64662, 0x5ad0e4d3, 12513, 4, 2
+	dd 20134, 42785, 2, 40157, 36427, 0xd118e7cd, 3731, 5
+	dd 0xd5c511fa, 34014, 4, 0, 0xe24d0358, 0x6e3a0713, 34334, 0x53ca05ff
+	dd 0x31f110e6, 46053, 28004, 0xbd9ae6c, 28619, 12580, 0x4a510a8a, 0x86ca19e7
+table_54_len equ $-table_54
+msg_54 db 'message number 54', 10, 0
+
+table_55:
+	dd 7167, 0xa98f8290, 6, 0x965374c1, 58739, 5, 7673, 0x59357025
+	dd 53003, 15100, 5, 60870, 1, 6, 22168, 9121
+	dd 0xd2850c3a, 6, 0xfc2e986a, 0xb51f6b05, 45825, 0xdf1cfda, 0x66fbfa79, 0x5207d0e4
+	dd 41882, 0x39d989ac, 35806, 2, 9, 0x9eb05e6c, 0xe2156cc2, 41895
+	dd 7, 9, 0x31e87318, 19317, 54161, 0xf4b5351f, 0xc0cb0fe5, 2
+	dd 8712, 8, 7, 0x3f89afc, 19399, 7, 8, 0x754fc260
+	dd 57936, 0, 0x7088cf2a, 56171, 2, 52981, 5, 18709
+	dd 17025, 0x6997ddad, 3, 8, 3, 28336, 0xf98315e1, 0x360e0182
+	dd 4, 1619, 0xa2bf49f3, 36691, 3, 0xd13a55b8, 9, 2
+	dd 0xc7baf8e2, 0x92c1fb0f, 7, 22870, 0x3d508fd0, 41371, 9, 25544
+	dd 1, 9, 22229, 2, 0xe0f4f64f, 5, 0xfde94616, 0xf7385b8b
+	dd 48497, 0xe3170cc1, 0x4730f4f9, 31013, 36928, 0x57ff7cc0, 0, 0xe671d242
+table_55_len equ $-table_55
+msg_55 db 'message number 55', 10, 0
+
+table_56:
+	dd 1107, 0x430f7ee9, 25910, 6, 0, 0xdaec86fe, 2, 0xb97b056f
+	dd 4, 1, 0xf7115ffc, 0xc62e41ac, 40144, 7, 58441, 0x2bcb48c3
+	dd 2, 2, 8, 0x722ba714, 21625, 0xd789d3e0, 0xd0256338, 3968
+	dd 0xa42d1793, 0x18f25cc0, 0x3e8f9347, 61956, 0x7d682a28, 0x9ff70b84, 1875, 0xc4d4c810
+	dd 0x299bb450, 0x70f5fe3b, 5, 64866, 2, 50382, 0xda46a620, 6084
+	dd 9, 2, 3, 56285, 40144, 3, 3359, 2
+	dd 7, 9, 6646, 0x3421cb13, 6, 27216, 0xf5792f84, 0xe33ba940
+	dd 0x4a329f86, 53770, 26666, 7, 41578, 57530, 25426, 53849
+	dd 0x27c07cf2, 25582, 0x7281710, 4111, 0x1bb1a720, 8, 6, 36052
+	dd 15916, 0x605bc9df, 48353, 0xf6876e60, 5, 20012, 8, 6
+	dd 0x10e30fd1, 1, 20494, 0, 0x8de0d896, 7, 9, 8
+	dd 0xdcb7393b, 38965, 7, 64634, 56207, 48905, 40917, 0xeb24c43
+table_56_len equ $-table_56
+msg_56 db 'message number 56', 10, 0
+
+table_57:
+	dd 0x6c58f4b7, 19453, 62110, 1, 35182, 5, 45712, 0xaba2e52c
+	dd 0x16707a13, 0x78cd84c8, 1, 29192, 0x72a9e9d3, 0xe3664599, 9, 0x8d225972
+	dd 63584, 9, 0x222250, 0x865493c5, 0x75ad8208, 32144, 6, 6
+	dd 0x996961b0, 4, 59496, 55184, 0xf8373e0c, 0x4ae99fe1, 38579, 9
+	dd 34433, 0x6f18c47a, 8, 29203, 48577, 1, 0xe23ae34f, 0x3e87bb72
+	dd 3, 0x86dd0132, 46039, 1, 0xa38013bc, 0, 0x9dc6386c, 0x98c6700
+	dd 0xd7836e6f, 35539, 14823, 0xba1e45f5, 42193, 5, 0xa44853f8, 1
+	dd 3, 6, 0x625d8602, 2322, 8, 0x93eb4ce4, 22613, 55545
+	dd 0xbdddecc9, 2207, 13236, 0xdb017120, 5, 9, 1, 9
+	dd 19606, 6, 0xde62ba9c, 0x3310a2b7, 6, 24672, 0x61a2d694, 5
+	dd 31931, 1, 45697, 8, 64845, 0x537e894b, 4, 63569
+	dd 19098, 7, 0xb30b112d, 63535, 8, 2, 16565, 4
+table_57_len equ $-table_57
+msg_57 db 'message number 57', 10, 0
+
+table_58:
+	dd 0xae5a1af1, 0x1f8a360b, 16969, 7, 5, 44741, 26444, 0x560ddf91
+	dd 0, 1, 27172, 7, 2, 0x74f9c279, 0, 8
+	dd 0x3c688670, 0x573a538f, 59656, 55402, 51663, 0, 0xe2ed9c06, 5
+	dd 6, 0x71a69731, 13445, 0x4c6cd04c, 0x34dc8e29, 2, 2, 0x63f0ef18
+	dd 8, 1, 5, 2, 4, 40395, 4, 0x465cebab
+	dd 0x9661b416, 3, 0x1063195e, 5, 28674, 35490, 5, 7037
+	dd 4, 0x91cdc82, 20031, 0x4f3debf7, 57044, 0xcacabdda, 9, 0x499e734e
+	dd 0x4735d939, 4705, 0x38736040, 15244, 0xbbed0de, 0x805a0684, 14489, 6
+	dd 44850, 0xfc08ea8f, 0, 0xec306bb7, 5, 0xa13f0b, 0xc27f2d8b, 64468
+	dd 3, 6, 0xb4c2753f, 0xa5df07a1, 3, 53135, 0x8682b9f5, 36754
+	dd 52797, 0x985c9c09, 0x4f87e67, 0xaaa0229f, 0x409d50bc, 2, 3, 27407
+	dd 8, 36063, 0x48c3e089, 2, 13820, 3685, 1, 0x392336cf
+table_58_len equ $-table_58
+msg_58 db 'message number 58', 10, 0
+
+table_59:
+	dd 0x25c3a1cf, 54818, 0x8d33efd5, 5, 60199, 27495, 37062, 41548
+	dd 0, 5, 55595, 3, 0, 0x734aa0db, 52598, 9
+	dd 0x6ed69aa2, 3581, 6, 1, 5, 0x9788bb6, 0x1f93e45f, 0x9fb39537
+	dd 12420, 24190, 39765, 8, 2, 3, 9, 45690
+	dd 0xed60f6f3, 0xa57b08ff, 3, 0xc3ecd901, 0xd32aa3a5, 0x676595d1, 43424, 38183
+	dd 5, 3, 0xee848ed8, 0x32157444, 0xe5ead776, 2, 3, 0x2e407914
+	dd 51470, 41295, 0xd69bd089, 8, 6, 0xa5ac5a2a, 2, 0x3d0c2b55
+	dd 0x3fe027aa, 6, 4, 55492, 2, 0xe36fc657, 8, 14397
+	dd 6, 4585, 6, 4, 7, 0x7027d8e3, 47849, 0x9e659db3
+	dd 6, 35495, 47198, 0x47655002, 18372, 6, 3, 0x3677c0a8
+	dd 0xd4306fec, 4, 1, 3, 0, 6, 0x21d61fd5, 0xe0b1071f
+	dd 6, 2, 3077, 5, 0x17b92a43, 49564, 0xc235810a, 38806
+table_59_len equ $-table_59
+msg_59 db 'message number 59', 10, 0
+
+section .bss
+
+buf_0: resb 4096
+buf_1: resb 4096
+buf_2: resb 4096
+buf_3: resb 256
+buf_4: resb 16
+buf_5: resb 4096
+buf_6: resb 16
+buf_7: resb 4096
+buf_8: resb 256
+buf_9: resb 16
+buf_10: resb 4096
+buf_11: resb 4096
+buf_12: resb 1
+buf_13: resb 256
+buf_14: resb 16
+buf_15: resb 4096
+buf_16: resb 16
+buf_17: resb 16
+buf_18: resb 256
+buf_19: resb 1
+buf_20: resb 256
+buf_21: resb 1
+buf_22: resb 1
+buf_23: resb 1
+buf_24: resb 256
+buf_25: resb 4096
+buf_26: resb 4096
+buf_27: resb 4096
+buf_28: resb 4096
+buf_29: resb 16
+buf_30: resb 16
+buf_31: resb 4096
+buf_32: resb 256
+buf_33: resb 4096
+buf_34: resb 4096
+buf_35: resb 4096
+buf_36: resb 256
+buf_37: resb 1
+buf_38: resb 1
+buf_39: resb 1
+buf_40: resb 16
+buf_41: resb 256
+buf_42: resb 1
+buf_43: resb 4096
+buf_44: resb 4096
+buf_45: resb 256
+buf_46: resb 16
+buf_47: resb 1
+buf_48: resb 256
+buf_49: resb 256
+buf_50: resb 4096
+buf_51: resb 4096
+buf_52: resb 4096
+buf_53: resb 1
+buf_54: resb 16
+buf_55: resb 256
+buf_56: resb 1
+buf_57: resb 1
+buf_58: resb 16
+buf_59: resb 1
+buf_60: resb 16
+buf_61: resb 4096
+buf_62: resb 256
+buf_63: resb 16
+buf_64: resb 16
+buf_65: resb 1
+buf_66: resb 256
+buf_67: resb 1
+buf_68: resb 16
+buf_69: resb 16
+buf_70: resb 256
+buf_71: resb 4096
+buf_72: resb 4096
+buf_73: resb 4096
+buf_74: resb 16
+buf_75: resb 4096
+buf_76: resb 256
+buf_77: resb 1
+buf_78: resb 16
+buf_79: resb 4096
+buf_80: resb 16
+buf_81: resb 4096
+buf_82: resb 1
+buf_83: resb 256
+buf_84: resb 1
+buf_85: resb 4096
+buf_86: resb 256
+buf_87: resb 16
+buf_88: resb 4096
+buf_89: resb 4096
+buf_90: resb 256
+buf_91: resb 256
+buf_92: resb 1
+buf_93: resb 16
+buf_94: resb 1
+buf_95: resb 4096
+buf_96: resb 256
+buf_97: resb 16
+buf_98: resb 256
+buf_99: resb 256
+buf_100: resb 4096
+buf_101: resb 16
+buf_102: resb 16
+buf_103: resb 16
+buf_104: resb 16
+buf_105: resb 16
+buf_106: resb 256
+buf_107: resb 16
+buf_108: resb 4096
+buf_109: resb 256
+buf_110: resb 4096
+buf_111: resb 16
+buf_112: resb 256
+buf_113: resb 1
+buf_114: resb 16
+buf_115: resb 1
+buf_116: resb 4096
+buf_117: resb 256
+buf_118: resb 256
+buf_119: resb 1
+buf_120: resb 256
+buf_121: resb 4096
+buf_122: resb 4096
+buf_123: resb 256
+buf_124: resb 16
+buf_125: resb 16
+buf_126: resb 16
+buf_127: resb 4096
+buf_128: resb 256
+buf_129: resb 4096
+buf_130: resb 256
+buf_131: resb 256
+buf_132: resb 1
+buf_133: resb 16
+buf_134: resb 4096
+buf_135: resb 4096
+buf_136: resb 4096
+buf_137: resb 4096
+buf_138: resb 256
+buf_139: resb 16
+buf_140: resb 4096
+buf_141: resb 16
+buf_142: resb 256
+buf_143: resb 16
+buf_144: resb 16
+buf_145: resb 4096
+buf_146: resb 1
+buf_147: resb 4096
+buf_148: resb 256
+buf_149: resb 16
+buf_150: resb 16
+buf_151: resb 1
+buf_152: resb 4096
+buf_153: resb 256
+buf_154: resb 4096
+buf_155: resb 256
+buf_156: resb 1
+buf_157: resb 256
+buf_158: resb 1
+buf_159: resb 1
+buf_160: resb 16
+buf_161: resb 4096
+buf_162: resb 1
+buf_163: resb 1
+buf_164: resb 4096
+buf_165: resb 4096
+buf_166: resb 1
+buf_167: resb 256
+buf_168: resb 16
+buf_169: resb 256
+buf_170: resb 4096
+buf_171: resb 4096
+buf_172: resb 1
+buf_173: resb 16
+buf_174: resb 4096
+buf_175: resb 16
+buf_176: resb 4096
+buf_177: resb 4096
+buf_178: resb 1
+buf_179: resb 1
+buf_180: resb 4096
+buf_181: resb 4096
+buf_182: resb 16
+buf_183: resb 16
+buf_184: resb 16
+buf_185: resb 256
+buf_186: resb 16
+buf_187: resb 4096
+buf_188: resb 16
+buf_189: resb 4096
+buf_190: resb 4096
+buf_191: resb 4096
+buf_192: resb 4096
+buf_193: resb 256
+buf_194: resb 256
+buf_195: resb 256
+buf_196: resb 16
+buf_197: resb 16
+buf_198: resb 4096
+buf_199: resb 16
+	times 16 db 0